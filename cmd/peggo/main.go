@@ -0,0 +1,14 @@
+package main
+
+import (
+	"os"
+
+	log "github.com/xlab/suplog"
+)
+
+func main() {
+	if err := NewRootCmd().Execute(); err != nil {
+		log.WithError(err).Errorln("peggo exited with error")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator"
+)
+
+const flagMetricsAddr = "metrics-addr"
+
+// NewRootCmd builds the peggo root command and wires in every subcommand. Any subcommand that
+// runs for the life of the process (e.g. run) inherits the
+// Prometheus metrics server started here when --metrics-addr is set.
+func NewRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "peggo",
+		Short: "Peggo is the Ethereum <> Injective Peggy bridge orchestrator",
+	}
+
+	cmd.PersistentFlags().String(flagMetricsAddr, "", "listen address for the Prometheus metrics server (disabled if empty)")
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		addr, err := cmd.Flags().GetString(flagMetricsAddr)
+		if err != nil || addr == "" {
+			return err
+		}
+
+		go func() {
+			if err := orchestrator.StartMetricsServer(cmd.Context(), addr); err != nil {
+				log.WithError(err).Errorln("metrics server exited")
+			}
+		}()
+
+		return nil
+	}
+
+	cmd.AddCommand(stateCmd(), runCmd())
+
+	return cmd
+}
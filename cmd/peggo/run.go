@@ -0,0 +1,161 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	log "github.com/xlab/suplog"
+
+	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
+	clientcommon "github.com/InjectiveLabs/sdk-go/client/common"
+	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+
+	"github.com/InjectiveLabs/peggo/orchestrator"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum"
+)
+
+const (
+	flagEthEndpoints        = "eth-endpoints"
+	flagEthSubscriptionMode = "eth-subscription-mode"
+	flagEthWSEndpoints      = "eth-ws-endpoints"
+	flagPeggyContract       = "peggy-contract"
+	flagLastObservedBlock   = "last-observed-eth-block"
+	flagMaxInFlightClaims   = "max-in-flight-claims"
+	flagClaimPollInterval   = "claim-poll-interval"
+	flagMaxLoopDuration     = "max-loop-duration"
+
+	flagCosmosChainID    = "cosmos-chain-id"
+	flagCosmosGRPC       = "cosmos-grpc"
+	flagTendermintRPC    = "tendermint-rpc"
+	flagCosmosKeyringDir = "cosmos-keyring-dir"
+	flagCosmosFrom       = "cosmos-from"
+	flagOrchestratorAddr = "orchestrator-address"
+)
+
+// runCmd starts the EthOracle main loop: it scans the configured Peggy contract for new events
+// and submits them to Injective as claims, persisting its progress under --state-dir as it goes.
+func runCmd() *cobra.Command {
+	var (
+		ethEndpoints        string
+		ethSubscriptionMode bool
+		ethWSEndpoints      string
+		peggyContract       string
+		lastObservedBlock   uint64
+		maxInFlightClaims   int
+		claimPollInterval   time.Duration
+		maxLoopDuration     time.Duration
+
+		cosmosChainID    string
+		cosmosGRPC       string
+		tendermintRPC    string
+		cosmosKeyringDir string
+		cosmosFrom       string
+		orchestratorAddr string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run the EthOracle main loop",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpoints := strings.Split(ethEndpoints, ",")
+			if len(endpoints) == 0 || endpoints[0] == "" {
+				return errors.Errorf("--%s must list at least one Ethereum endpoint", flagEthEndpoints)
+			}
+
+			if !common.IsHexAddress(peggyContract) {
+				return errors.Errorf("--%s is not a valid Ethereum address", flagPeggyContract)
+			}
+			contract := common.HexToAddress(peggyContract)
+
+			var wsEndpoints []string
+			if ethSubscriptionMode {
+				wsEndpoints = strings.Split(ethWSEndpoints, ",")
+				if len(wsEndpoints) == 0 || wsEndpoints[0] == "" {
+					return errors.Errorf("--%s must list at least one Ethereum websocket endpoint when --%s is set", flagEthWSEndpoints, flagEthSubscriptionMode)
+				}
+			}
+
+			stateDir, err := cmd.Flags().GetString(flagStateDir)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := orchestrator.NewOracleLoopConfig(orchestrator.OracleLoopConfigFlags{
+				EthEndpoints: endpoints,
+				Dial: func(endpoint string) (ethereum.EthClient, error) {
+					return ethereum.NewClient(endpoint, contract)
+				},
+				SubscriptionMode: ethSubscriptionMode,
+				WSEndpoints:      wsEndpoints,
+				WSDial: func(endpoint string) (ethereum.WSClient, error) {
+					return ethclient.Dial(endpoint)
+				},
+				PeggyContract:     contract,
+				StateDir:          stateDir,
+				MaxInFlightClaims: maxInFlightClaims,
+				ClaimPollInterval: claimPollInterval,
+				MaxLoopDuration:   maxLoopDuration,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to build oracle loop config")
+			}
+
+			keyring, err := cosmoskeyring.New("peggo", cosmoskeyring.BackendTest, cosmosKeyringDir, nil, nil)
+			if err != nil {
+				return errors.Wrap(err, "failed to open Cosmos keyring")
+			}
+
+			chainClient, err := chainclient.NewChainClient(
+				clientcommon.LoadNetwork("mainnet", "lb"),
+				chainclient.OptionChainID(cosmosChainID),
+				chainclient.OptionCosmosGRPC(cosmosGRPC),
+				chainclient.OptionTMEndpoint(tendermintRPC),
+				chainclient.OptionKeyring(keyring, cosmosFrom),
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to build Injective chain client")
+			}
+
+			inj, err := orchestrator.NewInjectiveClient(orchestrator.InjectiveClientConfig{
+				ChainClient:      chainClient,
+				OrchestratorAddr: orchestratorAddr,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to build Injective client")
+			}
+
+			orc := orchestrator.NewPeggyOrchestrator(log.WithField("module", "peggo"), inj, orchestratorAddr)
+
+			return orc.EthOracleMainLoop(cmd.Context(), lastObservedBlock, cfg)
+		},
+	}
+
+	cmd.Flags().String(flagStateDir, orchestrator.DefaultStateDir, "directory holding the orchestrator's persisted checkpoint")
+	cmd.Flags().StringVar(&ethEndpoints, flagEthEndpoints, "", "comma-separated list of Ethereum RPC endpoints, failed over to in order")
+	cmd.Flags().BoolVar(&ethSubscriptionMode, flagEthSubscriptionMode, false, "use a websocket subscription for Peggy events instead of polling eth_getLogs")
+	cmd.Flags().StringVar(&ethWSEndpoints, flagEthWSEndpoints, "", "comma-separated list of Ethereum websocket endpoints, rotated to the next one on a dropped subscription, required when --"+flagEthSubscriptionMode+" is set")
+	cmd.Flags().StringVar(&peggyContract, flagPeggyContract, "", "address of the Peggy contract to track")
+	cmd.Flags().Uint64Var(&lastObservedBlock, flagLastObservedBlock, 0, "Ethereum height to start scanning from when no checkpoint is persisted yet")
+	cmd.Flags().IntVar(&maxInFlightClaims, flagMaxInFlightClaims, 5, "maximum number of claim broadcasts allowed in flight at once")
+	cmd.Flags().DurationVar(&claimPollInterval, flagClaimPollInterval, 2*time.Second, "interval at which in-flight claim txs are polled for completion")
+	cmd.Flags().DurationVar(&maxLoopDuration, flagMaxLoopDuration, 5*time.Minute, "upper bound the adaptive loop interval backs off to on a quiet chain")
+	cmd.Flags().StringVar(&cosmosChainID, flagCosmosChainID, "injective-1", "Injective chain ID to sign claim txs for")
+	cmd.Flags().StringVar(&cosmosGRPC, flagCosmosGRPC, "", "Injective gRPC endpoint")
+	cmd.Flags().StringVar(&tendermintRPC, flagTendermintRPC, "", "Injective Tendermint RPC endpoint")
+	cmd.Flags().StringVar(&cosmosKeyringDir, flagCosmosKeyringDir, "", "directory holding the orchestrator's Cosmos keyring")
+	cmd.Flags().StringVar(&cosmosFrom, flagCosmosFrom, "", "name of the orchestrator's key in the Cosmos keyring")
+	cmd.Flags().StringVar(&orchestratorAddr, flagOrchestratorAddr, "", "orchestrator's Injective (bech32) address")
+
+	cmd.MarkFlagRequired(flagEthEndpoints)
+	cmd.MarkFlagRequired(flagPeggyContract)
+	cmd.MarkFlagRequired(flagCosmosGRPC)
+	cmd.MarkFlagRequired(flagTendermintRPC)
+	cmd.MarkFlagRequired(flagCosmosFrom)
+	cmd.MarkFlagRequired(flagOrchestratorAddr)
+
+	return cmd
+}
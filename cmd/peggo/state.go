@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/InjectiveLabs/peggo/orchestrator"
+)
+
+const flagStateDir = "state-dir"
+
+// stateCmd inspects and seeds the orchestrator's persisted checkpoint, so operators can recover
+// from an unexpected state without having to re-scan from Injective's last claimed event.
+func stateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Inspect or seed the orchestrator's persisted checkpoint",
+	}
+
+	cmd.PersistentFlags().String(flagStateDir, orchestrator.DefaultStateDir, "directory holding the orchestrator's persisted checkpoint")
+
+	cmd.AddCommand(stateDumpCmd(), stateRestoreCmd())
+
+	return cmd
+}
+
+func stateDumpCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dump",
+		Short: "Print the persisted checkpoint as JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stateDir, err := cmd.Flags().GetString(flagStateDir)
+			if err != nil {
+				return err
+			}
+
+			store, err := orchestrator.NewCheckpointStore(stateDir)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			cp, err := store.Load()
+			if err != nil {
+				return err
+			}
+			if cp == nil {
+				return errors.Errorf("no checkpoint found in state dir %s", stateDir)
+			}
+
+			out, err := json.MarshalIndent(cp, "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal checkpoint")
+			}
+
+			fmt.Println(string(out))
+
+			return nil
+		},
+	}
+}
+
+func stateRestoreCmd() *cobra.Command {
+	var lastCheckedEthHeight uint64
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Seed the persisted checkpoint with an explicit last-checked Ethereum height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stateDir, err := cmd.Flags().GetString(flagStateDir)
+			if err != nil {
+				return err
+			}
+
+			store, err := orchestrator.NewCheckpointStore(stateDir)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			cp, err := store.Load()
+			if err != nil {
+				return err
+			}
+			if cp == nil {
+				cp = &orchestrator.Checkpoint{}
+			}
+
+			cp.LastCheckedEthHeight = lastCheckedEthHeight
+
+			return store.Save(cp)
+		},
+	}
+
+	cmd.Flags().Uint64Var(&lastCheckedEthHeight, "last-checked-eth-height", 0, "Ethereum height to resume scanning from")
+
+	return cmd
+}
@@ -0,0 +1,118 @@
+package orchestrator
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+// DefaultStateDir is where the orchestrator persists its checkpoint when no
+// --state-dir is configured.
+const DefaultStateDir = ".peggo/state"
+
+var checkpointBucket = []byte("checkpoint")
+var checkpointKey = []byte("state")
+
+// Checkpoint is the crash-safe snapshot of EthOracleMainLoop's progress. It is persisted so a
+// restart can resume close to where it left off instead of re-scanning thousands of blocks based
+// on Injective's last claimed event.
+type Checkpoint struct {
+	LastCheckedEthHeight    uint64
+	LastResyncWithInjective time.Time
+	// LastClaimedEventNonces is the last Ethereum event nonce claimed per event type, keyed by the
+	// same short names used by eventTypeKey.
+	LastClaimedEventNonces map[string]uint64
+	ReorgWindow            []blockRecord
+}
+
+// CheckpointStore persists a Checkpoint across orchestrator restarts.
+type CheckpointStore interface {
+	Load() (*Checkpoint, error)
+	Save(cp *Checkpoint) error
+	Close() error
+}
+
+// boltCheckpointStore is a CheckpointStore backed by a single bolt file under the orchestrator's
+// configured state directory.
+type boltCheckpointStore struct {
+	db *bolt.DB
+}
+
+// NewCheckpointStore opens (creating if necessary) a bolt-backed CheckpointStore at
+// <stateDir>/checkpoint.db.
+func NewCheckpointStore(stateDir string) (CheckpointStore, error) {
+	db, err := bolt.Open(filepath.Join(stateDir, "checkpoint.db"), 0600, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open checkpoint store")
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(checkpointBucket)
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to init checkpoint bucket")
+	}
+
+	return &boltCheckpointStore{db: db}, nil
+}
+
+func (s *boltCheckpointStore) Load() (*Checkpoint, error) {
+	var cp *Checkpoint
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(checkpointBucket).Get(checkpointKey)
+		if raw == nil {
+			return nil
+		}
+
+		cp = &Checkpoint{}
+		return json.Unmarshal(raw, cp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load checkpoint")
+	}
+
+	return cp, nil
+}
+
+func (s *boltCheckpointStore) Save(cp *Checkpoint) error {
+	raw, err := json.Marshal(cp)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal checkpoint")
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(checkpointBucket).Put(checkpointKey, raw)
+	})
+}
+
+func (s *boltCheckpointStore) Close() error {
+	return s.db.Close()
+}
+
+// persistCheckpoint saves the loop's current progress to its CheckpointStore, if one is
+// configured. It is a no-op otherwise.
+func (l *ethOracleLoop) persistCheckpoint() error {
+	if l.checkpointStore == nil {
+		return nil
+	}
+
+	l.lastSentNoncesMu.Lock()
+	lastClaimedEventNonces := make(map[string]uint64, len(l.lastSentNonces))
+	for eventType, nonce := range l.lastSentNonces {
+		lastClaimedEventNonces[eventType] = nonce
+	}
+	l.lastSentNoncesMu.Unlock()
+
+	cp := &Checkpoint{
+		LastCheckedEthHeight:    l.lastCheckedEthHeight,
+		LastResyncWithInjective: l.lastResyncWithInjective,
+		LastClaimedEventNonces:  lastClaimedEventNonces,
+		ReorgWindow:             l.reorgWindow,
+	}
+
+	return errors.Wrap(l.checkpointStore.Save(cp), "failed to persist oracle checkpoint")
+}
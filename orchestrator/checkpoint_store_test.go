@@ -0,0 +1,43 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointStoreSaveLoadRoundTrip(t *testing.T) {
+	store, err := NewCheckpointStore(t.TempDir())
+	require.NoError(t, err)
+	defer store.Close()
+
+	cp, err := store.Load()
+	require.NoError(t, err)
+	require.Nil(t, cp, "a fresh store must report no checkpoint")
+
+	want := &Checkpoint{
+		LastCheckedEthHeight:    12345,
+		LastResyncWithInjective: time.Now().UTC().Truncate(time.Second),
+		LastClaimedEventNonces:  map[string]uint64{"deposit": 7},
+		ReorgWindow:             []blockRecord{{Number: 12340}},
+	}
+	require.NoError(t, store.Save(want))
+
+	got, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestCheckpointStoreSaveOverwritesPreviousCheckpoint(t *testing.T) {
+	store, err := NewCheckpointStore(t.TempDir())
+	require.NoError(t, err)
+	defer store.Close()
+
+	require.NoError(t, store.Save(&Checkpoint{LastCheckedEthHeight: 1}))
+	require.NoError(t, store.Save(&Checkpoint{LastCheckedEthHeight: 2}))
+
+	got, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), got.LastCheckedEthHeight)
+}
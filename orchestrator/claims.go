@@ -0,0 +1,217 @@
+package orchestrator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+	"go.opentelemetry.io/otel/attribute"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// claimSubmission tracks a single in-flight claim broadcast: the event it carries, the Cosmos
+// account sequence it was signed with, and the tx hash returned by the broadcast.
+type claimSubmission struct {
+	event    any
+	sequence uint64
+	txHash   string
+}
+
+// sendClaimsPipelined signs and broadcasts each event with an explicit, locally incremented
+// Cosmos account sequence. Broadcasts are submitted strictly in sequence order — CheckTx rejects
+// rather than queues a tx whose sequence isn't exactly the account's next expected one, so
+// submitting out of order would fail synchronously — while up to maxInFlightClaims broadcasts may
+// be awaiting confirmation at once, replacing the fixed inter-claim sleep with a bounded pipeline.
+func (l *ethOracleLoop) sendClaimsPipelined(ctx context.Context, events []any) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	ctx, span := startSpan(ctx, "EthOracleLoop.sendClaimsPipelined", attribute.Int("claims", len(events)))
+	var err error
+	defer func() { endSpan(span, err) }()
+
+	var sequence uint64
+	sequence, err = l.inj.GetSequence(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get Injective account sequence")
+	}
+
+	maxInFlight := l.maxInFlightClaims
+	if maxInFlight <= 0 {
+		maxInFlight = defaultMaxInFlightClaims
+	}
+
+	var (
+		mu       sync.Mutex
+		sem      = make(chan struct{}, maxInFlight)
+		wg       sync.WaitGroup
+		firstErr error
+	)
+
+	for _, event := range events {
+		mu.Lock()
+		aborted := firstErr != nil
+		mu.Unlock()
+		if aborted {
+			break
+		}
+
+		sem <- struct{}{}
+
+		txHash, sendErr := l.sendEthEventClaim(ctx, event, sequence)
+		if sendErr != nil {
+			// A synchronous CheckTx rejection (e.g. another process advanced the account
+			// sequence) gets one resubmit attempt with a freshly fetched sequence, mirroring the
+			// resubmit path awaitClaimResult takes for mismatches reported asynchronously.
+			if refreshed, refreshErr := l.inj.GetSequence(ctx); refreshErr == nil {
+				sequence = refreshed
+				txHash, sendErr = l.sendEthEventClaim(ctx, event, sequence)
+			}
+		}
+
+		if sendErr != nil {
+			<-sem
+			mu.Lock()
+			if firstErr == nil {
+				firstErr = errors.Wrapf(sendErr, "failed to broadcast claim at sequence %d", sequence)
+			}
+			mu.Unlock()
+			break
+		}
+
+		sub := &claimSubmission{event: event, sequence: sequence, txHash: txHash}
+		sequence++
+
+		wg.Add(1)
+		go func(sub *claimSubmission) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := l.awaitClaimResult(ctx, sub); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(sub)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		err = firstErr
+		return err
+	}
+
+	l.Logger().WithField("claims", len(events)).Infoln("sent new event claims to Injective")
+
+	return nil
+}
+
+// awaitClaimResult polls a single broadcast claim tx until it confirms, resubmitting it if it was
+// rejected for a sequence mismatch (e.g. another process advanced the account sequence in the
+// meantime) after refreshing the sequence from the chain. It gives up with an error after
+// maxClaimPollAttempts so a tx that's silently evicted from the mempool and never resolves can't
+// wedge the oracle loop forever.
+func (l *ethOracleLoop) awaitClaimResult(ctx context.Context, sub *claimSubmission) error {
+	pollInterval := l.claimPollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultClaimPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for attempt := 0; attempt < maxClaimPollAttempts; attempt++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		status, err := l.inj.TxStatus(ctx, sub.txHash)
+		if err != nil {
+			return errors.Wrapf(err, "failed to poll claim tx %s", sub.txHash)
+		}
+
+		switch {
+		case status.Confirmed:
+			eventType, nonce := eventTypeKey(sub.event), eventNonce(sub.event)
+			l.lastSentNoncesMu.Lock()
+			l.lastSentNonces[eventType] = nonce
+			l.lastSentNoncesMu.Unlock()
+			metricEventsClaimedTotal.WithLabelValues(eventType).Inc()
+			metricLastClaimedEventNonce.WithLabelValues(eventType).Set(float64(nonce))
+			return nil
+		case status.SequenceMismatch:
+			if err := l.resubmitClaim(ctx, sub); err != nil {
+				return err
+			}
+		}
+	}
+
+	return errors.Errorf("claim tx %s (sequence %d) did not confirm after %d poll attempts", sub.txHash, sub.sequence, maxClaimPollAttempts)
+}
+
+// resubmitClaim refreshes the account sequence from the chain and re-broadcasts sub's event with
+// it, updating sub in place.
+func (l *ethOracleLoop) resubmitClaim(ctx context.Context, sub *claimSubmission) error {
+	sequence, err := l.inj.GetSequence(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to refresh Injective account sequence after mismatch")
+	}
+
+	txHash, err := l.sendEthEventClaim(ctx, sub.event, sequence)
+	if err != nil {
+		return errors.Wrap(err, "failed to resubmit claim after sequence mismatch")
+	}
+
+	l.Logger().WithFields(log.Fields{"old_sequence": sub.sequence, "new_sequence": sequence}).Infoln("resubmitted claim after sequence mismatch")
+
+	sub.sequence = sequence
+	sub.txHash = txHash
+
+	return nil
+}
+
+// eventNonce returns the Ethereum event nonce a claim event carries.
+func eventNonce(event any) uint64 {
+	switch e := event.(type) {
+	case *peggyevents.PeggySendToCosmosEvent:
+		return e.EventNonce.Uint64()
+	case *peggyevents.PeggySendToInjectiveEvent:
+		return e.EventNonce.Uint64()
+	case *peggyevents.PeggyValsetUpdatedEvent:
+		return e.EventNonce.Uint64()
+	case *peggyevents.PeggyTransactionBatchExecutedEvent:
+		return e.EventNonce.Uint64()
+	case *peggyevents.PeggyERC20DeployedEvent:
+		return e.EventNonce.Uint64()
+	default:
+		panic(errors.Errorf("unknown event type %T", e))
+	}
+}
+
+// eventTypeKey is the short, stable name used to key a claimed event's nonce in the persisted
+// checkpoint.
+func eventTypeKey(event any) string {
+	switch event.(type) {
+	case *peggyevents.PeggySendToCosmosEvent:
+		return "old_deposit"
+	case *peggyevents.PeggySendToInjectiveEvent:
+		return "deposit"
+	case *peggyevents.PeggyValsetUpdatedEvent:
+		return "valset_update"
+	case *peggyevents.PeggyTransactionBatchExecutedEvent:
+		return "withdrawal"
+	case *peggyevents.PeggyERC20DeployedEvent:
+		return "erc20_deployment"
+	default:
+		panic(errors.Errorf("unknown event type %T", event))
+	}
+}
@@ -0,0 +1,79 @@
+package orchestrator
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// confirmingInjectiveClient is a fake InjectiveClient whose TxStatus reports every tx confirmed on
+// the first poll, so awaitClaimResult returns as soon as its goroutine is scheduled.
+type confirmingInjectiveClient struct{}
+
+func (confirmingInjectiveClient) GetSequence(context.Context) (uint64, error) { return 0, nil }
+
+func (confirmingInjectiveClient) TxStatus(context.Context, string) (InjTxStatus, error) {
+	return InjTxStatus{Confirmed: true}, nil
+}
+
+func (confirmingInjectiveClient) LastClaimEventByAddr(context.Context, string) (*InjectiveLastClaimEvent, error) {
+	return &InjectiveLastClaimEvent{}, nil
+}
+
+func (confirmingInjectiveClient) SendOldDepositClaim(context.Context, *peggyevents.PeggySendToCosmosEvent, uint64) (string, error) {
+	return "", nil
+}
+
+func (confirmingInjectiveClient) SendDepositClaim(context.Context, *peggyevents.PeggySendToInjectiveEvent, uint64) (string, error) {
+	return "", nil
+}
+
+func (confirmingInjectiveClient) SendValsetClaim(context.Context, *peggyevents.PeggyValsetUpdatedEvent, uint64) (string, error) {
+	return "", nil
+}
+
+func (confirmingInjectiveClient) SendWithdrawalClaim(context.Context, *peggyevents.PeggyTransactionBatchExecutedEvent, uint64) (string, error) {
+	return "", nil
+}
+
+func (confirmingInjectiveClient) SendERC20DeployedClaim(context.Context, *peggyevents.PeggyERC20DeployedEvent, uint64) (string, error) {
+	return "", nil
+}
+
+// TestAwaitClaimResultConcurrentConfirmationsDoNotRaceOnLastSentNonces confirms multiple claims of
+// different event types at once, the way sendClaimsPipelined's fanned-out goroutines do. Run with
+// -race: before lastSentNoncesMu existed, this reliably tripped Go's concurrent map write detector.
+func TestAwaitClaimResultConcurrentConfirmationsDoNotRaceOnLastSentNonces(t *testing.T) {
+	loop := &ethOracleLoop{
+		PeggyOrchestrator: &PeggyOrchestrator{inj: confirmingInjectiveClient{}},
+		claimPollInterval: time.Millisecond,
+		lastSentNonces:    map[string]uint64{},
+	}
+
+	submissions := []*claimSubmission{
+		{event: &peggyevents.PeggySendToCosmosEvent{EventNonce: big.NewInt(1), Raw: ethtypes.Log{BlockNumber: 1}}, txHash: "0x1"},
+		{event: &peggyevents.PeggySendToInjectiveEvent{EventNonce: big.NewInt(2), Raw: ethtypes.Log{BlockNumber: 2}}, txHash: "0x2"},
+		{event: &peggyevents.PeggyValsetUpdatedEvent{EventNonce: big.NewInt(3), Raw: ethtypes.Log{BlockNumber: 3}}, txHash: "0x3"},
+		{event: &peggyevents.PeggyTransactionBatchExecutedEvent{EventNonce: big.NewInt(4), Raw: ethtypes.Log{BlockNumber: 4}}, txHash: "0x4"},
+		{event: &peggyevents.PeggyERC20DeployedEvent{EventNonce: big.NewInt(5), Raw: ethtypes.Log{BlockNumber: 5}}, txHash: "0x5"},
+	}
+
+	var wg sync.WaitGroup
+	for _, sub := range submissions {
+		wg.Add(1)
+		go func(sub *claimSubmission) {
+			defer wg.Done()
+			require.NoError(t, loop.awaitClaimResult(context.Background(), sub))
+		}(sub)
+	}
+	wg.Wait()
+
+	require.Len(t, loop.lastSentNonces, len(submissions))
+}
@@ -0,0 +1,80 @@
+package orchestrator
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum"
+)
+
+// OracleLoopConfigFlags are the operator-facing settings NewOracleLoopConfig turns into a running
+// OracleLoopConfig: which Ethereum endpoints to dial, whether to prefer the push-style websocket
+// subscription source over plain eth_getLogs polling, and where to persist the oracle's checkpoint.
+type OracleLoopConfigFlags struct {
+	// EthEndpoints is the ordered list of Ethereum RPC endpoints to dial, failing over to the
+	// next one on repeated failures. Must contain at least one endpoint.
+	EthEndpoints []string
+
+	// Dial opens an EthClient against a single endpoint. Called once up front and again on every
+	// endpoint rotation.
+	Dial ethereum.DialFunc
+
+	// SubscriptionMode switches the event source from polling eth_getLogs to a websocket
+	// subscription, falling back to a bounded range query only on a buffer gap.
+	SubscriptionMode bool
+
+	// WSEndpoints is the ordered list of Ethereum websocket endpoints to subscribe over, rotated
+	// to the next one if the active subscription fails to dial or drops. Required when
+	// SubscriptionMode is set, and must contain at least one endpoint.
+	WSEndpoints []string
+
+	// WSDial opens a WSClient against a single websocket endpoint. Called once up front and
+	// again on every websocket endpoint rotation. Required when SubscriptionMode is set.
+	WSDial ethereum.WSDialFunc
+
+	// PeggyContract is the address of the Peggy contract whose events are tracked.
+	PeggyContract common.Address
+
+	// StateDir is where the orchestrator's checkpoint is persisted.
+	StateDir string
+
+	MaxInFlightClaims int
+	ClaimPollInterval time.Duration
+	MaxLoopDuration   time.Duration
+}
+
+// NewOracleLoopConfig builds the EthEventSource, endpoint pool, and checkpoint store
+// EthOracleMainLoop needs from flags, dialing the first configured Ethereum endpoint and opening
+// the checkpoint store in the process.
+func NewOracleLoopConfig(flags OracleLoopConfigFlags) (OracleLoopConfig, error) {
+	pool, err := ethereum.NewEndpointPool(flags.EthEndpoints, flags.Dial)
+	if err != nil {
+		return OracleLoopConfig{}, errors.Wrap(err, "failed to build Ethereum endpoint pool")
+	}
+
+	var source ethereum.EthEventSource
+	if flags.SubscriptionMode {
+		source, err = ethereum.NewSubscriptionEventSource(flags.WSEndpoints, flags.WSDial, pool, flags.PeggyContract)
+		if err != nil {
+			return OracleLoopConfig{}, errors.Wrap(err, "failed to build subscription Ethereum event source")
+		}
+	} else {
+		source = ethereum.NewPollingEventSource(pool)
+	}
+
+	checkpointStore, err := NewCheckpointStore(flags.StateDir)
+	if err != nil {
+		return OracleLoopConfig{}, errors.Wrap(err, "failed to open checkpoint store")
+	}
+
+	return OracleLoopConfig{
+		EventSource:       source,
+		EndpointPool:      pool,
+		CheckpointStore:   checkpointStore,
+		MaxInFlightClaims: flags.MaxInFlightClaims,
+		ClaimPollInterval: flags.ClaimPollInterval,
+		MaxLoopDuration:   flags.MaxLoopDuration,
+	}, nil
+}
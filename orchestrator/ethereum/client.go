@@ -0,0 +1,122 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+
+	ethgo "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// Client is the default EthClient, backed by a single JSON-RPC connection to an Ethereum node.
+// It's the DialFunc NewEndpointPool/OracleLoopConfigFlags use unless a test supplies a fake one.
+type Client struct {
+	rpc      *ethclient.Client
+	contract common.Address
+	filterer *peggyevents.PeggyFilterer
+}
+
+// NewClient dials endpoint and returns an EthClient scoped to contract's events.
+func NewClient(endpoint string, contract common.Address) (EthClient, error) {
+	rpc, err := ethclient.Dial(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial Ethereum endpoint %s", endpoint)
+	}
+
+	filterer, err := peggyevents.NewPeggyFilterer(contract, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build Peggy log filterer")
+	}
+
+	return &Client{rpc: rpc, contract: contract, filterer: filterer}, nil
+}
+
+func (c *Client) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	return c.rpc.HeaderByNumber(ctx, number)
+}
+
+// filterLogs runs an unfiltered-by-topic eth_getLogs query over [startBlock, endBlock], relying on
+// the caller to pick out the log types it wants via the PeggyFilterer Parse* methods — the same
+// split responsibility SubscriptionEventSource.ingestLog uses for push-delivered logs.
+func (c *Client) filterLogs(startBlock, endBlock uint64) ([]ethtypes.Log, error) {
+	return c.rpc.FilterLogs(context.Background(), ethgo.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(startBlock),
+		ToBlock:   new(big.Int).SetUint64(endBlock),
+		Addresses: []common.Address{c.contract},
+	})
+}
+
+func (c *Client) GetSendToCosmosEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToCosmosEvent, error) {
+	logs, err := c.filterLogs(startBlock, endBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to filter SendToCosmos logs")
+	}
+
+	var events []*peggyevents.PeggySendToCosmosEvent
+	for _, vLog := range logs {
+		parseInto(&events, c.filterer.ParseSendToCosmos, vLog)
+	}
+
+	return events, nil
+}
+
+func (c *Client) GetSendToInjectiveEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToInjectiveEvent, error) {
+	logs, err := c.filterLogs(startBlock, endBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to filter SendToInjective logs")
+	}
+
+	var events []*peggyevents.PeggySendToInjectiveEvent
+	for _, vLog := range logs {
+		parseInto(&events, c.filterer.ParseSendToInjective, vLog)
+	}
+
+	return events, nil
+}
+
+func (c *Client) GetTransactionBatchExecutedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyTransactionBatchExecutedEvent, error) {
+	logs, err := c.filterLogs(startBlock, endBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to filter TransactionBatchExecuted logs")
+	}
+
+	var events []*peggyevents.PeggyTransactionBatchExecutedEvent
+	for _, vLog := range logs {
+		parseInto(&events, c.filterer.ParseTransactionBatchExecuted, vLog)
+	}
+
+	return events, nil
+}
+
+func (c *Client) GetPeggyERC20DeployedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyERC20DeployedEvent, error) {
+	logs, err := c.filterLogs(startBlock, endBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to filter PeggyERC20Deployed logs")
+	}
+
+	var events []*peggyevents.PeggyERC20DeployedEvent
+	for _, vLog := range logs {
+		parseInto(&events, c.filterer.ParsePeggyERC20Deployed, vLog)
+	}
+
+	return events, nil
+}
+
+func (c *Client) GetValsetUpdatedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyValsetUpdatedEvent, error) {
+	logs, err := c.filterLogs(startBlock, endBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to filter ValsetUpdated logs")
+	}
+
+	var events []*peggyevents.PeggyValsetUpdatedEvent
+	for _, vLog := range logs {
+		parseInto(&events, c.filterer.ParseValsetUpdated, vLog)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,143 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/xlab/suplog"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// EndpointPool satisfies EthClient so it can be passed anywhere a single client is expected.
+var _ EthClient = (*EndpointPool)(nil)
+
+var metricEndpointRotationsTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "peggo",
+	Subsystem: "ethereum",
+	Name:      "endpoint_rotations_total",
+	Help:      "Total number of times the orchestrator rotated to the next configured Ethereum endpoint.",
+})
+
+// DialFunc dials an Ethereum endpoint and returns a client for it. Passed to NewEndpointPool so
+// the pool can redial on rotation without depending on a specific client implementation.
+type DialFunc func(endpoint string) (EthClient, error)
+
+// EndpointPool round-robins between a set of Ethereum RPC endpoints, rotating
+// to the next one once an endpoint has exhausted its retry attempts. It implements EthClient
+// itself, delegating every call to whichever endpoint is presently active, so it can be handed
+// directly to NewPollingEventSource/NewSubscriptionEventSource in place of a single client.
+type EndpointPool struct {
+	mu        sync.Mutex
+	endpoints []string
+	current   int
+	dial      DialFunc
+	client    EthClient
+}
+
+// NewEndpointPool builds a pool over the given endpoints, dialing the first one immediately. The
+// first endpoint in the slice is used first.
+func NewEndpointPool(endpoints []string, dial DialFunc) (*EndpointPool, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no Ethereum endpoints configured")
+	}
+
+	client, err := dial(endpoints[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial Ethereum endpoint %s", endpoints[0])
+	}
+
+	return &EndpointPool{endpoints: endpoints, dial: dial, client: client}, nil
+}
+
+// Current returns the endpoint that should presently be used.
+func (p *EndpointPool) Current() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.endpoints[p.current]
+}
+
+func (p *EndpointPool) activeClient() EthClient {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.client
+}
+
+// RotateOnFailure advances the pool to the next endpoint after the current one has exhausted its
+// retry attempts, redials it, and swaps it in as the active client so every subsequent EthClient
+// call goes to the new endpoint. It is a no-op (returning the same endpoint) when only one
+// endpoint is configured or when redialing the next endpoint fails.
+func (p *EndpointPool) RotateOnFailure() string {
+	p.mu.Lock()
+	if len(p.endpoints) < 2 {
+		defer p.mu.Unlock()
+		return p.endpoints[p.current]
+	}
+	prev := p.endpoints[p.current]
+	nextIdx := (p.current + 1) % len(p.endpoints)
+	nextEndpoint := p.endpoints[nextIdx]
+	p.mu.Unlock()
+
+	client, err := p.dial(nextEndpoint)
+	if err != nil {
+		log.WithError(err).WithField("endpoint", nextEndpoint).Warningln("failed to dial next configured Ethereum endpoint, keeping current one")
+		return prev
+	}
+
+	p.mu.Lock()
+	p.current = nextIdx
+	p.client = client
+	p.mu.Unlock()
+
+	metricEndpointRotationsTotal.Inc()
+	log.WithFields(log.Fields{"from": prev, "to": nextEndpoint}).Warningln("rotating to next configured Ethereum endpoint after repeated failures")
+
+	return nextEndpoint
+}
+
+func (p *EndpointPool) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	header, err := p.activeClient().HeaderByNumber(ctx, number)
+	return header, p.annotate(err)
+}
+
+func (p *EndpointPool) GetSendToCosmosEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToCosmosEvent, error) {
+	events, err := p.activeClient().GetSendToCosmosEvents(startBlock, endBlock)
+	return events, p.annotate(err)
+}
+
+func (p *EndpointPool) GetSendToInjectiveEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToInjectiveEvent, error) {
+	events, err := p.activeClient().GetSendToInjectiveEvents(startBlock, endBlock)
+	return events, p.annotate(err)
+}
+
+func (p *EndpointPool) GetTransactionBatchExecutedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyTransactionBatchExecutedEvent, error) {
+	events, err := p.activeClient().GetTransactionBatchExecutedEvents(startBlock, endBlock)
+	return events, p.annotate(err)
+}
+
+func (p *EndpointPool) GetPeggyERC20DeployedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyERC20DeployedEvent, error) {
+	events, err := p.activeClient().GetPeggyERC20DeployedEvents(startBlock, endBlock)
+	return events, p.annotate(err)
+}
+
+func (p *EndpointPool) GetValsetUpdatedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyValsetUpdatedEvent, error) {
+	events, err := p.activeClient().GetValsetUpdatedEvents(startBlock, endBlock)
+	return events, p.annotate(err)
+}
+
+// annotate wraps err (if any) with the endpoint that was active when the call was made, so a
+// failure downstream in retry logs points at a specific endpoint instead of "the client".
+func (p *EndpointPool) annotate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return errors.Wrapf(err, "endpoint %s", p.Current())
+}
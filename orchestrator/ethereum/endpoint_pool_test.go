@@ -0,0 +1,48 @@
+package ethereum
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointPoolCurrentAndRotate(t *testing.T) {
+	dialed := make([]string, 0, 2)
+	pool := &EndpointPool{
+		endpoints: []string{"endpoint-a", "endpoint-b"},
+		dial: func(endpoint string) (EthClient, error) {
+			dialed = append(dialed, endpoint)
+			return nil, nil
+		},
+	}
+
+	require.Equal(t, "endpoint-a", pool.Current())
+
+	next := pool.RotateOnFailure()
+
+	require.Equal(t, "endpoint-b", next)
+	require.Equal(t, "endpoint-b", pool.Current())
+	require.Equal(t, []string{"endpoint-b"}, dialed, "RotateOnFailure must redial the endpoint it switches to")
+}
+
+func TestEndpointPoolSingleEndpointRotateIsNoop(t *testing.T) {
+	pool := &EndpointPool{endpoints: []string{"only"}}
+
+	require.Equal(t, "only", pool.RotateOnFailure())
+	require.Equal(t, "only", pool.Current())
+}
+
+func TestEndpointPoolRotateKeepsCurrentOnDialFailure(t *testing.T) {
+	pool := &EndpointPool{
+		endpoints: []string{"endpoint-a", "endpoint-b"},
+		dial: func(endpoint string) (EthClient, error) {
+			return nil, errors.New("dial failed")
+		},
+	}
+
+	next := pool.RotateOnFailure()
+
+	require.Equal(t, "endpoint-a", next, "a failed redial must not advance the pool")
+	require.Equal(t, "endpoint-a", pool.Current())
+}
@@ -0,0 +1,175 @@
+package ethereum
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// Events groups every Peggy contract event type the oracle loop cares about
+// for a single block range.
+type Events struct {
+	OldDeposits      []*peggyevents.PeggySendToCosmosEvent
+	Deposits         []*peggyevents.PeggySendToInjectiveEvent
+	Withdrawals      []*peggyevents.PeggyTransactionBatchExecutedEvent
+	ValsetUpdates    []*peggyevents.PeggyValsetUpdatedEvent
+	ERC20Deployments []*peggyevents.PeggyERC20DeployedEvent
+}
+
+func (e Events) Num() int {
+	return len(e.OldDeposits) + len(e.Deposits) + len(e.Withdrawals) + len(e.ValsetUpdates) + len(e.ERC20Deployments)
+}
+
+func (e Events) Filter(nonce uint64) Events {
+	var oldDeposits []*peggyevents.PeggySendToCosmosEvent
+	for _, d := range e.OldDeposits {
+		if d.EventNonce.Uint64() > nonce {
+			oldDeposits = append(oldDeposits, d)
+		}
+	}
+
+	var deposits []*peggyevents.PeggySendToInjectiveEvent
+	for _, d := range e.Deposits {
+		if d.EventNonce.Uint64() > nonce {
+			deposits = append(deposits, d)
+		}
+	}
+
+	var withdrawals []*peggyevents.PeggyTransactionBatchExecutedEvent
+	for _, w := range e.Withdrawals {
+		if w.EventNonce.Uint64() > nonce {
+			withdrawals = append(withdrawals, w)
+		}
+	}
+
+	var valsetUpdates []*peggyevents.PeggyValsetUpdatedEvent
+	for _, vs := range e.ValsetUpdates {
+		if vs.EventNonce.Uint64() > nonce {
+			valsetUpdates = append(valsetUpdates, vs)
+		}
+	}
+
+	var erc20Deployments []*peggyevents.PeggyERC20DeployedEvent
+	for _, d := range e.ERC20Deployments {
+		if d.EventNonce.Uint64() > nonce {
+			erc20Deployments = append(erc20Deployments, d)
+		}
+	}
+
+	return Events{
+		OldDeposits:      oldDeposits,
+		Deposits:         deposits,
+		Withdrawals:      withdrawals,
+		ValsetUpdates:    valsetUpdates,
+		ERC20Deployments: erc20Deployments,
+	}
+}
+
+func (e Events) Sort() []any {
+	events := make([]any, 0, e.Num())
+
+	for _, deposit := range e.OldDeposits {
+		events = append(events, deposit)
+	}
+
+	for _, deposit := range e.Deposits {
+		events = append(events, deposit)
+	}
+
+	for _, withdrawal := range e.Withdrawals {
+		events = append(events, withdrawal)
+	}
+
+	for _, deployment := range e.ERC20Deployments {
+		events = append(events, deployment)
+	}
+
+	for _, vs := range e.ValsetUpdates {
+		events = append(events, vs)
+	}
+
+	eventNonce := func(event any) uint64 {
+		switch e := event.(type) {
+		case *peggyevents.PeggySendToCosmosEvent:
+			return e.EventNonce.Uint64()
+		case *peggyevents.PeggySendToInjectiveEvent:
+			return e.EventNonce.Uint64()
+		case *peggyevents.PeggyValsetUpdatedEvent:
+			return e.EventNonce.Uint64()
+		case *peggyevents.PeggyTransactionBatchExecutedEvent:
+			return e.EventNonce.Uint64()
+		case *peggyevents.PeggyERC20DeployedEvent:
+			return e.EventNonce.Uint64()
+		default:
+			panic(errors.Errorf("unknown event type %T", e))
+		}
+	}
+
+	// sort by nonce
+	sort.Slice(events, func(i, j int) bool {
+		return eventNonce(events[i]) < eventNonce(events[j])
+	})
+
+	return events
+}
+
+// CountsByType returns the number of events of each type in e, keyed by the same short type
+// names used to label oracle metrics (eventTypeKey in the orchestrator package).
+func (e Events) CountsByType() map[string]int {
+	return map[string]int{
+		"old_deposit":      len(e.OldDeposits),
+		"deposit":          len(e.Deposits),
+		"withdrawal":       len(e.Withdrawals),
+		"valset_update":    len(e.ValsetUpdates),
+		"erc20_deployment": len(e.ERC20Deployments),
+	}
+}
+
+// Prune drops every event whose source block is below minBlock. Used to bound the in-memory
+// buffer a subscription-based event source accumulates, since only events at or above the
+// oracle loop's current scan position are still needed.
+func (e Events) Prune(minBlock uint64) Events {
+	inRange := func(n uint64) bool { return n >= minBlock }
+
+	var out Events
+	for _, ev := range e.OldDeposits {
+		if inRange(ev.Raw.BlockNumber) {
+			out.OldDeposits = append(out.OldDeposits, ev)
+		}
+	}
+	for _, ev := range e.Deposits {
+		if inRange(ev.Raw.BlockNumber) {
+			out.Deposits = append(out.Deposits, ev)
+		}
+	}
+	for _, ev := range e.Withdrawals {
+		if inRange(ev.Raw.BlockNumber) {
+			out.Withdrawals = append(out.Withdrawals, ev)
+		}
+	}
+	for _, ev := range e.ValsetUpdates {
+		if inRange(ev.Raw.BlockNumber) {
+			out.ValsetUpdates = append(out.ValsetUpdates, ev)
+		}
+	}
+	for _, ev := range e.ERC20Deployments {
+		if inRange(ev.Raw.BlockNumber) {
+			out.ERC20Deployments = append(out.ERC20Deployments, ev)
+		}
+	}
+
+	return out
+}
+
+// Merge appends other's events onto e and returns the combined result.
+func (e Events) Merge(other Events) Events {
+	return Events{
+		OldDeposits:      append(append([]*peggyevents.PeggySendToCosmosEvent{}, e.OldDeposits...), other.OldDeposits...),
+		Deposits:         append(append([]*peggyevents.PeggySendToInjectiveEvent{}, e.Deposits...), other.Deposits...),
+		Withdrawals:      append(append([]*peggyevents.PeggyTransactionBatchExecutedEvent{}, e.Withdrawals...), other.Withdrawals...),
+		ValsetUpdates:    append(append([]*peggyevents.PeggyValsetUpdatedEvent{}, e.ValsetUpdates...), other.ValsetUpdates...),
+		ERC20Deployments: append(append([]*peggyevents.PeggyERC20DeployedEvent{}, e.ERC20Deployments...), other.ERC20Deployments...),
+	}
+}
@@ -0,0 +1,94 @@
+package ethereum
+
+import (
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+func depositAt(nonce, blockNumber uint64) *peggyevents.PeggySendToInjectiveEvent {
+	return &peggyevents.PeggySendToInjectiveEvent{
+		EventNonce: new(big.Int).SetUint64(nonce),
+		Raw:        ethtypes.Log{BlockNumber: blockNumber},
+	}
+}
+
+func TestEventsFilter(t *testing.T) {
+	events := Events{Deposits: []*peggyevents.PeggySendToInjectiveEvent{
+		depositAt(1, 100), depositAt(2, 101), depositAt(3, 102),
+	}}
+
+	filtered := events.Filter(2)
+
+	require.Len(t, filtered.Deposits, 1)
+	require.Equal(t, uint64(3), filtered.Deposits[0].EventNonce.Uint64())
+}
+
+func TestEventsSortOrdersByNonceAcrossTypes(t *testing.T) {
+	events := Events{
+		Deposits:    []*peggyevents.PeggySendToInjectiveEvent{depositAt(3, 100)},
+		OldDeposits: []*peggyevents.PeggySendToCosmosEvent{{EventNonce: new(big.Int).SetUint64(1), Raw: ethtypes.Log{BlockNumber: 98}}},
+	}
+	events.Withdrawals = []*peggyevents.PeggyTransactionBatchExecutedEvent{
+		{EventNonce: new(big.Int).SetUint64(2), Raw: ethtypes.Log{BlockNumber: 99}},
+	}
+
+	sorted := events.Sort()
+	require.Len(t, sorted, 3)
+
+	nonce := func(event any) uint64 {
+		switch e := event.(type) {
+		case *peggyevents.PeggySendToCosmosEvent:
+			return e.EventNonce.Uint64()
+		case *peggyevents.PeggySendToInjectiveEvent:
+			return e.EventNonce.Uint64()
+		case *peggyevents.PeggyTransactionBatchExecutedEvent:
+			return e.EventNonce.Uint64()
+		default:
+			t.Fatalf("unexpected event type %T", event)
+			return 0
+		}
+	}
+
+	require.Equal(t, uint64(1), nonce(sorted[0]))
+	require.Equal(t, uint64(2), nonce(sorted[1]))
+	require.Equal(t, uint64(3), nonce(sorted[2]))
+}
+
+func TestEventsMerge(t *testing.T) {
+	a := Events{Deposits: []*peggyevents.PeggySendToInjectiveEvent{depositAt(1, 100)}}
+	b := Events{Deposits: []*peggyevents.PeggySendToInjectiveEvent{depositAt(2, 101)}}
+
+	merged := a.Merge(b)
+
+	require.Len(t, merged.Deposits, 2)
+	require.Len(t, a.Deposits, 1, "Merge must not mutate its receiver")
+}
+
+func TestEventsPruneDropsBelowMinBlock(t *testing.T) {
+	events := Events{Deposits: []*peggyevents.PeggySendToInjectiveEvent{
+		depositAt(1, 100), depositAt(2, 105), depositAt(3, 110),
+	}}
+
+	pruned := events.Prune(105)
+
+	require.Len(t, pruned.Deposits, 2)
+	require.Equal(t, uint64(2), pruned.Deposits[0].EventNonce.Uint64())
+	require.Equal(t, uint64(3), pruned.Deposits[1].EventNonce.Uint64())
+}
+
+func TestByBlockRange(t *testing.T) {
+	events := Events{Deposits: []*peggyevents.PeggySendToInjectiveEvent{
+		depositAt(1, 99), depositAt(2, 100), depositAt(3, 105), depositAt(4, 106),
+	}}
+
+	ranged := byBlockRange(events, 100, 105)
+
+	require.Len(t, ranged.Deposits, 2)
+	require.Equal(t, uint64(2), ranged.Deposits[0].EventNonce.Uint64())
+	require.Equal(t, uint64(3), ranged.Deposits[1].EventNonce.Uint64())
+}
@@ -0,0 +1,116 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// EthClient is the subset of the Ethereum JSON-RPC client the event sources
+// need: header lookups plus scoped event queries. The websocket subscription
+// primitives live in WSClient.
+type EthClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+
+	GetSendToCosmosEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToCosmosEvent, error)
+	GetSendToInjectiveEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToInjectiveEvent, error)
+	GetTransactionBatchExecutedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyTransactionBatchExecutedEvent, error)
+	GetPeggyERC20DeployedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyERC20DeployedEvent, error)
+	GetValsetUpdatedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyValsetUpdatedEvent, error)
+}
+
+// EthEventSource abstracts how the oracle loop learns about new Peggy
+// contract events and the current Ethereum chain height. It exists so the
+// oracle loop can be switched between plain eth_getLogs polling and a
+// push-style websocket subscription without changing its control flow.
+type EthEventSource interface {
+	// LatestHeight returns the current Ethereum chain height.
+	LatestHeight(ctx context.Context) (uint64, error)
+
+	// HeaderByNumber returns the header for a specific height, used for reorg detection.
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+
+	// FetchEvents returns every Peggy event emitted in [startBlock, endBlock].
+	FetchEvents(ctx context.Context, startBlock, endBlock uint64) (Events, error)
+}
+
+// PollingEventSource is the original eth_getLogs-based EthEventSource: every
+// call to FetchEvents issues a bounded range query against the node.
+type PollingEventSource struct {
+	client EthClient
+}
+
+// NewPollingEventSource returns an EthEventSource that queries event logs
+// directly over the given range on every call to FetchEvents.
+func NewPollingEventSource(client EthClient) *PollingEventSource {
+	return &PollingEventSource{client: client}
+}
+
+func (s *PollingEventSource) LatestHeight(ctx context.Context) (uint64, error) {
+	header, err := s.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get latest ethereum header")
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+func (s *PollingEventSource) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	header, err := s.client.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ethereum header")
+	}
+
+	return header, nil
+}
+
+func (s *PollingEventSource) FetchEvents(ctx context.Context, startBlock, endBlock uint64) (Events, error) {
+	return fetchEventRange(s.client, startBlock, endBlock)
+}
+
+// fetchEventRange issues the five scoped eth_getLogs queries for a block
+// range. Shared by PollingEventSource and SubscriptionEventSource's gap
+// fallback.
+func fetchEventRange(client EthClient, startBlock, endBlock uint64) (Events, error) {
+	legacyDeposits, err := client.GetSendToCosmosEvents(startBlock, endBlock)
+	if err != nil {
+		return Events{}, errors.Wrap(err, "failed to get SendToCosmos events")
+	}
+
+	deposits, err := client.GetSendToInjectiveEvents(startBlock, endBlock)
+	if err != nil {
+		return Events{}, errors.Wrap(err, "failed to get SendToInjective events")
+	}
+
+	withdrawals, err := client.GetTransactionBatchExecutedEvents(startBlock, endBlock)
+	if err != nil {
+		return Events{}, errors.Wrap(err, "failed to get TransactionBatchExecuted events")
+	}
+
+	erc20Deployments, err := client.GetPeggyERC20DeployedEvents(startBlock, endBlock)
+	if err != nil {
+		return Events{}, errors.Wrap(err, "failed to get ERC20Deployed events")
+	}
+
+	valsetUpdates, err := client.GetValsetUpdatedEvents(startBlock, endBlock)
+	if err != nil {
+		return Events{}, errors.Wrap(err, "failed to get ValsetUpdated events")
+	}
+
+	return Events{
+		OldDeposits:      legacyDeposits,
+		Deposits:         deposits,
+		Withdrawals:      withdrawals,
+		ValsetUpdates:    valsetUpdates,
+		ERC20Deployments: erc20Deployments,
+	}, nil
+}
+
+func sourceLogger() log.Logger {
+	return log.WithField("component", "EthEventSource")
+}
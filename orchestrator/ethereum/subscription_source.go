@@ -0,0 +1,359 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	ethgo "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/xlab/suplog"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+var metricWSEndpointRotationsTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "peggo",
+	Subsystem: "ethereum",
+	Name:      "ws_endpoint_rotations_total",
+	Help:      "Total number of times the subscription event source rotated to the next configured websocket endpoint.",
+})
+
+// WSClient is the subset of a websocket-capable Ethereum client needed to
+// receive push-style chain head and log notifications.
+type WSClient interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *ethtypes.Header) (ethgo.Subscription, error)
+	SubscribeFilterLogs(ctx context.Context, q ethgo.FilterQuery, ch chan<- ethtypes.Log) (ethgo.Subscription, error)
+}
+
+// WSDialFunc dials a websocket Ethereum endpoint and returns a client for it. Passed to
+// NewSubscriptionEventSource so it can redial on rotation without depending on a specific client
+// implementation, mirroring DialFunc/EndpointPool on the polling side.
+type WSDialFunc func(endpoint string) (WSClient, error)
+
+// SubscriptionEventSource is an EthEventSource that receives new Peggy
+// contract logs and chain heads push-style over a websocket subscription,
+// buffering them in memory. FetchEvents only falls back to a bounded
+// eth_getLogs range query when the requested range isn't fully covered by
+// what the subscription has observed so far (e.g. right after startup, or
+// after a dropped/resubscribed connection).
+type SubscriptionEventSource struct {
+	fallback EthClient
+	contract common.Address
+	filterer *peggyevents.PeggyFilterer
+
+	startOnce sync.Once
+
+	// wsMu guards the active websocket client and which configured endpoint it's dialed against,
+	// separately from mu's buffered-event state since rotating endpoints and ingesting logs are
+	// independent concerns.
+	wsMu        sync.Mutex
+	wsEndpoints []string
+	wsCurrent   int
+	wsDial      WSDialFunc
+	ws          WSClient
+
+	mu           sync.Mutex
+	buffered     Events
+	bufferFrom   uint64
+	bufferTo     uint64
+	latestHeight uint64
+	// stale is set whenever the log subscription drops and cleared once the
+	// resulting gap has been backfilled via eth_getLogs. It is tracked
+	// explicitly rather than inferred from bufferFrom/bufferTo so a log that
+	// arrives after resubscribing can't make a stale buffer look covered
+	// again before the gap has actually been filled.
+	stale bool
+}
+
+// NewSubscriptionEventSource builds a subscription-based EthEventSource, dialing the first of
+// wsEndpoints immediately. If the active websocket subscription fails to dial or drops, it
+// rotates to the next configured endpoint the same way EndpointPool does for the polling/fallback
+// side, instead of retrying the same endpoint forever. fallback is used both for bounded range
+// queries on a buffer gap and for HeaderByNumber lookups at arbitrary heights.
+func NewSubscriptionEventSource(wsEndpoints []string, wsDial WSDialFunc, fallback EthClient, contract common.Address) (*SubscriptionEventSource, error) {
+	if len(wsEndpoints) == 0 {
+		return nil, errors.New("no Ethereum websocket endpoints configured")
+	}
+
+	ws, err := wsDial(wsEndpoints[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial Ethereum websocket endpoint %s", wsEndpoints[0])
+	}
+
+	filterer, err := peggyevents.NewPeggyFilterer(contract, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build Peggy log filterer")
+	}
+
+	return &SubscriptionEventSource{
+		wsEndpoints: wsEndpoints,
+		wsDial:      wsDial,
+		ws:          ws,
+		fallback:    fallback,
+		contract:    contract,
+		filterer:    filterer,
+	}, nil
+}
+
+func (s *SubscriptionEventSource) ensureSubscribed(ctx context.Context) {
+	s.startOnce.Do(func() {
+		go s.subscribeHeads(ctx)
+		go s.subscribeLogs(ctx)
+	})
+}
+
+// activeWS returns the websocket client that should presently be used.
+func (s *SubscriptionEventSource) activeWS() WSClient {
+	s.wsMu.Lock()
+	defer s.wsMu.Unlock()
+
+	return s.ws
+}
+
+// rotateWS advances to the next configured websocket endpoint and redials it, swapping it in as
+// the active client. It mirrors EndpointPool.RotateOnFailure: a no-op (keeping the current
+// endpoint) when only one is configured or when redialing the next one fails.
+func (s *SubscriptionEventSource) rotateWS() {
+	s.wsMu.Lock()
+	if len(s.wsEndpoints) < 2 {
+		s.wsMu.Unlock()
+		return
+	}
+	prev := s.wsEndpoints[s.wsCurrent]
+	nextIdx := (s.wsCurrent + 1) % len(s.wsEndpoints)
+	next := s.wsEndpoints[nextIdx]
+	s.wsMu.Unlock()
+
+	ws, err := s.wsDial(next)
+	if err != nil {
+		sourceLogger().WithError(err).WithField("endpoint", next).Warningln("failed to dial next configured Ethereum websocket endpoint, keeping current one")
+		return
+	}
+
+	s.wsMu.Lock()
+	s.wsCurrent = nextIdx
+	s.ws = ws
+	s.wsMu.Unlock()
+
+	metricWSEndpointRotationsTotal.Inc()
+	sourceLogger().WithFields(log.Fields{"from": prev, "to": next}).Warningln("rotating to next configured Ethereum websocket endpoint after a dropped subscription")
+}
+
+func (s *SubscriptionEventSource) subscribeHeads(ctx context.Context) {
+	headCh := make(chan *ethtypes.Header)
+
+	sub, err := s.activeWS().SubscribeNewHead(ctx, headCh)
+	if err != nil {
+		sourceLogger().WithError(err).Warningln("failed to subscribe to new Ethereum heads, rotating and retrying")
+		s.rotateWS()
+		go s.subscribeHeads(ctx)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-sub.Err():
+			sourceLogger().WithError(err).Warningln("Ethereum head subscription dropped, will rotate and resubscribe")
+			s.rotateWS()
+			go s.subscribeHeads(ctx)
+			return
+		case header := <-headCh:
+			s.mu.Lock()
+			if height := header.Number.Uint64(); height > s.latestHeight {
+				s.latestHeight = height
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *SubscriptionEventSource) subscribeLogs(ctx context.Context) {
+	logCh := make(chan ethtypes.Log)
+	query := ethgo.FilterQuery{Addresses: []common.Address{s.contract}}
+
+	sub, err := s.activeWS().SubscribeFilterLogs(ctx, query, logCh)
+	if err != nil {
+		sourceLogger().WithError(err).Warningln("failed to subscribe to Peggy contract logs, rotating and retrying")
+		s.rotateWS()
+		go s.subscribeLogs(ctx)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-sub.Err():
+			sourceLogger().WithError(err).Warningln("Peggy log subscription dropped, will rotate and resubscribe (a gap may need the eth_getLogs fallback)")
+			s.mu.Lock()
+			s.stale = true // force the next FetchEvents to fall back until the gap is backfilled
+			s.mu.Unlock()
+			s.rotateWS()
+			go s.subscribeLogs(ctx)
+			return
+		case vLog := <-logCh:
+			s.ingestLog(vLog)
+		}
+	}
+}
+
+func (s *SubscriptionEventSource) ingestLog(vLog ethtypes.Log) {
+	var event Events
+
+	switch {
+	case parseInto(&event.OldDeposits, s.filterer.ParseSendToCosmos, vLog):
+	case parseInto(&event.Deposits, s.filterer.ParseSendToInjective, vLog):
+	case parseInto(&event.Withdrawals, s.filterer.ParseTransactionBatchExecuted, vLog):
+	case parseInto(&event.ValsetUpdates, s.filterer.ParseValsetUpdated, vLog):
+	case parseInto(&event.ERC20Deployments, s.filterer.ParsePeggyERC20Deployed, vLog):
+	default:
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.buffered = s.buffered.Merge(event)
+	if s.bufferFrom == 0 || vLog.BlockNumber < s.bufferFrom {
+		s.bufferFrom = vLog.BlockNumber
+	}
+	if vLog.BlockNumber > s.bufferTo {
+		s.bufferTo = vLog.BlockNumber
+	}
+}
+
+// parseInto attempts to decode vLog with parse, appending the result to dst on success. It
+// returns whether the log matched this event type, so ingestLog can use it as a type switch.
+func parseInto[T any](dst *[]*T, parse func(ethtypes.Log) (*T, error), vLog ethtypes.Log) bool {
+	event, err := parse(vLog)
+	if err != nil || event == nil {
+		return false
+	}
+
+	*dst = append(*dst, event)
+	return true
+}
+
+func (s *SubscriptionEventSource) LatestHeight(ctx context.Context) (uint64, error) {
+	s.ensureSubscribed(ctx)
+
+	s.mu.Lock()
+	height := s.latestHeight
+	s.mu.Unlock()
+
+	if height > 0 {
+		return height, nil
+	}
+
+	// no head observed yet (e.g. subscription just started) — poll once directly
+	header, err := s.fallback.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get latest ethereum header")
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+// HeaderByNumber is served by the fallback client directly — reorg detection needs
+// arbitrary historical heights that the head subscription never pushes.
+func (s *SubscriptionEventSource) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	header, err := s.fallback.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ethereum header")
+	}
+
+	return header, nil
+}
+
+func (s *SubscriptionEventSource) FetchEvents(ctx context.Context, startBlock, endBlock uint64) (Events, error) {
+	s.ensureSubscribed(ctx)
+
+	s.mu.Lock()
+	covered := !s.stale && s.bufferTo > 0 && s.bufferFrom <= startBlock && s.bufferTo >= endBlock
+	events := s.buffered
+	s.mu.Unlock()
+
+	var (
+		result Events
+		err    error
+	)
+
+	if covered {
+		result = byBlockRange(events, startBlock, endBlock)
+	} else {
+		sourceLogger().WithFields(log.Fields{"start": startBlock, "end": endBlock}).Debugln("subscription buffer does not cover requested range, falling back to eth_getLogs")
+
+		result, err = fetchEventRange(s.fallback, startBlock, endBlock)
+		if err != nil {
+			return Events{}, err
+		}
+
+		s.mu.Lock()
+		s.stale = false // the gap up to endBlock has now been backfilled
+		s.mu.Unlock()
+	}
+
+	s.pruneBuffered(endBlock)
+
+	return result, nil
+}
+
+// pruneBuffered drops buffered events below scannedThrough, the height the oracle loop has just
+// finished scanning up to. Without this, every log received over the subscription for the life of
+// the process stays in memory — this keeps the buffer bounded to the not-yet-scanned tail.
+func (s *SubscriptionEventSource) pruneBuffered(scannedThrough uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.buffered = s.buffered.Prune(scannedThrough)
+	if s.buffered.Num() == 0 {
+		s.bufferFrom, s.bufferTo = 0, 0
+		return
+	}
+	if s.bufferFrom < scannedThrough {
+		s.bufferFrom = scannedThrough
+	}
+}
+
+// byBlockRange returns the subset of events whose source block falls within [startBlock, endBlock].
+func byBlockRange(events Events, startBlock, endBlock uint64) Events {
+	inRange := func(n uint64) bool { return n >= startBlock && n <= endBlock }
+
+	var out Events
+	for _, e := range events.OldDeposits {
+		if inRange(e.Raw.BlockNumber) {
+			out.OldDeposits = append(out.OldDeposits, e)
+		}
+	}
+	for _, e := range events.Deposits {
+		if inRange(e.Raw.BlockNumber) {
+			out.Deposits = append(out.Deposits, e)
+		}
+	}
+	for _, e := range events.Withdrawals {
+		if inRange(e.Raw.BlockNumber) {
+			out.Withdrawals = append(out.Withdrawals, e)
+		}
+	}
+	for _, e := range events.ValsetUpdates {
+		if inRange(e.Raw.BlockNumber) {
+			out.ValsetUpdates = append(out.ValsetUpdates, e)
+		}
+	}
+	for _, e := range events.ERC20Deployments {
+		if inRange(e.Raw.BlockNumber) {
+			out.ERC20Deployments = append(out.ERC20Deployments, e)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,139 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethgo "github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// noopWSClient refuses every subscription, so ensureSubscribed's background
+// goroutines never receive a head or log (they keep retrying the subscription)
+// and leave the buffer to be driven by the test instead of a real feed.
+type noopWSClient struct{}
+
+func (noopWSClient) SubscribeNewHead(ctx context.Context, ch chan<- *ethtypes.Header) (ethgo.Subscription, error) {
+	return nil, errors.New("no websocket in test")
+}
+
+func (noopWSClient) SubscribeFilterLogs(ctx context.Context, q ethgo.FilterQuery, ch chan<- ethtypes.Log) (ethgo.Subscription, error) {
+	return nil, errors.New("no websocket in test")
+}
+
+// fallbackCallRecorder is a minimal EthClient that records the ranges it was
+// asked to fetch and returns a single canned deposit for each call.
+type fallbackCallRecorder struct {
+	calls [][2]uint64
+}
+
+func (f *fallbackCallRecorder) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(0)}, nil
+}
+
+func (f *fallbackCallRecorder) GetSendToCosmosEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToCosmosEvent, error) {
+	return nil, nil
+}
+
+func (f *fallbackCallRecorder) GetSendToInjectiveEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToInjectiveEvent, error) {
+	f.calls = append(f.calls, [2]uint64{startBlock, endBlock})
+	return []*peggyevents.PeggySendToInjectiveEvent{depositAt(1, startBlock)}, nil
+}
+
+func (f *fallbackCallRecorder) GetTransactionBatchExecutedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyTransactionBatchExecutedEvent, error) {
+	return nil, nil
+}
+
+func (f *fallbackCallRecorder) GetPeggyERC20DeployedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyERC20DeployedEvent, error) {
+	return nil, nil
+}
+
+func (f *fallbackCallRecorder) GetValsetUpdatedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyValsetUpdatedEvent, error) {
+	return nil, nil
+}
+
+// TestSubscriptionEventSourceRotatesWSEndpointOnDrop reproduces a dropped websocket subscription
+// with more than one configured endpoint: rotateWS must redial the next endpoint and swap it in
+// as the active client, rather than leaving the source retrying the same dead endpoint forever.
+func TestSubscriptionEventSourceRotatesWSEndpointOnDrop(t *testing.T) {
+	var dialed []string
+	source := &SubscriptionEventSource{
+		wsEndpoints: []string{"ws://primary", "ws://secondary"},
+		ws:          noopWSClient{},
+		wsDial: func(endpoint string) (WSClient, error) {
+			dialed = append(dialed, endpoint)
+			return noopWSClient{}, nil
+		},
+	}
+
+	source.rotateWS()
+
+	require.Equal(t, []string{"ws://secondary"}, dialed)
+	require.Equal(t, 1, source.wsCurrent)
+
+	source.rotateWS()
+
+	require.Equal(t, []string{"ws://secondary", "ws://primary"}, dialed, "rotation must wrap back around to the first endpoint")
+	require.Equal(t, 0, source.wsCurrent)
+}
+
+// TestSubscriptionEventSourceRotateWSNoopsWithOneEndpoint confirms a single-endpoint source never
+// redials, matching EndpointPool.RotateOnFailure's behavior on the polling side.
+func TestSubscriptionEventSourceRotateWSNoopsWithOneEndpoint(t *testing.T) {
+	dialed := false
+	source := &SubscriptionEventSource{
+		wsEndpoints: []string{"ws://only"},
+		ws:          noopWSClient{},
+		wsDial: func(endpoint string) (WSClient, error) {
+			dialed = true
+			return noopWSClient{}, nil
+		},
+	}
+
+	source.rotateWS()
+
+	require.False(t, dialed)
+	require.Equal(t, 0, source.wsCurrent)
+}
+
+// TestSubscriptionEventSourceDropForcesFallbackUntilBackfilled reproduces a
+// subscription drop that straddles a gap: events keep arriving and pushing
+// bufferTo upward after resubscribing, but the gap opened during the drop
+// must still be served from eth_getLogs until it's explicitly backfilled.
+func TestSubscriptionEventSourceDropForcesFallbackUntilBackfilled(t *testing.T) {
+	fallback := &fallbackCallRecorder{}
+	source := &SubscriptionEventSource{ws: noopWSClient{}, fallback: fallback}
+
+	source.ingestLog(ethtypes.Log{BlockNumber: 100})
+	require.Equal(t, uint64(100), source.bufferFrom)
+	require.Equal(t, uint64(100), source.bufferTo)
+
+	// simulate the subscription dropping and a log arriving after resubscribe,
+	// before the gap has been backfilled
+	source.mu.Lock()
+	source.stale = true
+	source.mu.Unlock()
+	source.ingestLog(ethtypes.Log{BlockNumber: 105})
+
+	require.Equal(t, uint64(100), source.bufferFrom)
+	require.Equal(t, uint64(105), source.bufferTo, "bufferTo alone must not make the range look covered again")
+
+	_, err := source.FetchEvents(context.Background(), 100, 105)
+	require.NoError(t, err)
+	require.Len(t, fallback.calls, 1, "a stale buffer must fall back to eth_getLogs even though bufferFrom/bufferTo nominally cover the range")
+
+	source.mu.Lock()
+	stale := source.stale
+	source.mu.Unlock()
+	require.False(t, stale, "FetchEvents must clear stale once the gap has been backfilled")
+
+	// subsequent fetch within the now-backfilled range should be served from the buffer
+	_, err = source.FetchEvents(context.Background(), 101, 105)
+	require.NoError(t, err)
+	require.Len(t, fallback.calls, 1, "once backfilled, a covered range must not hit the fallback again")
+}
@@ -0,0 +1,41 @@
+package orchestrator
+
+import (
+	"context"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// InjTxStatus reports the on-chain outcome of a previously broadcast claim tx, as returned by
+// InjectiveClient.TxStatus.
+type InjTxStatus struct {
+	Confirmed        bool
+	SequenceMismatch bool
+}
+
+// InjectiveLastClaimEvent is the most recent Peggy event this orchestrator address has claimed on
+// Injective, as returned by InjectiveClient.LastClaimEventByAddr.
+type InjectiveLastClaimEvent struct {
+	EthereumEventNonce  uint64
+	EthereumEventHeight uint64
+}
+
+// InjectiveClient is the subset of Injective chain access EthOracleMainLoop needs: reading this
+// orchestrator's account sequence and last-claimed event, polling a broadcast tx's status, and
+// broadcasting each of the five Peggy claim types.
+type InjectiveClient interface {
+	// GetSequence returns this orchestrator's current Cosmos account sequence.
+	GetSequence(ctx context.Context) (uint64, error)
+
+	// TxStatus reports whether a previously broadcast claim tx has confirmed.
+	TxStatus(ctx context.Context, txHash string) (InjTxStatus, error)
+
+	// LastClaimEventByAddr returns the most recent Peggy event orchestratorAddr has claimed.
+	LastClaimEventByAddr(ctx context.Context, orchestratorAddr string) (*InjectiveLastClaimEvent, error)
+
+	SendOldDepositClaim(ctx context.Context, event *peggyevents.PeggySendToCosmosEvent, sequence uint64) (string, error)
+	SendDepositClaim(ctx context.Context, event *peggyevents.PeggySendToInjectiveEvent, sequence uint64) (string, error)
+	SendValsetClaim(ctx context.Context, event *peggyevents.PeggyValsetUpdatedEvent, sequence uint64) (string, error)
+	SendWithdrawalClaim(ctx context.Context, event *peggyevents.PeggyTransactionBatchExecutedEvent, sequence uint64) (string, error)
+	SendERC20DeployedClaim(ctx context.Context, event *peggyevents.PeggyERC20DeployedEvent, sequence uint64) (string, error)
+}
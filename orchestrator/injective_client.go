@@ -0,0 +1,150 @@
+package orchestrator
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
+	peggytypes "github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+
+	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// InjectiveClientConfig holds an already-built chain client and the orchestrator's Injective
+// address. peggo run builds the chain client from its --cosmos-* flags before passing it in.
+type InjectiveClientConfig struct {
+	ChainClient      chainclient.ChainClient
+	OrchestratorAddr string
+}
+
+// injectiveClient is the default InjectiveClient, backed by a chainclient.ChainClient signing and
+// broadcasting Peggy claim messages under the orchestrator's Cosmos account.
+type injectiveClient struct {
+	chain chainclient.ChainClient
+	addr  string
+}
+
+// NewInjectiveClient wraps an already-dialed chainclient.ChainClient as an InjectiveClient scoped
+// to orchestratorAddr. Building the ChainClient itself (gRPC dial, keyring, chain ID) is left to
+// the caller, same as how ethereum.NewClient is handed an already-resolved endpoint rather than
+// owning endpoint selection.
+func NewInjectiveClient(cfg InjectiveClientConfig) (InjectiveClient, error) {
+	if cfg.ChainClient == nil {
+		return nil, errors.New("chain client is required")
+	}
+	if cfg.OrchestratorAddr == "" {
+		return nil, errors.New("orchestrator address is required")
+	}
+
+	return &injectiveClient{chain: cfg.ChainClient, addr: cfg.OrchestratorAddr}, nil
+}
+
+func (c *injectiveClient) GetSequence(ctx context.Context) (uint64, error) {
+	_, sequence, err := c.chain.GetAccountNonce(ctx, chainclient.QueryTypeCommitted)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to query Injective account sequence")
+	}
+
+	return sequence, nil
+}
+
+func (c *injectiveClient) TxStatus(ctx context.Context, txHash string) (InjTxStatus, error) {
+	res, err := c.chain.GetTx(ctx, txHash)
+	if err != nil {
+		return InjTxStatus{}, errors.Wrapf(err, "failed to query tx %s", txHash)
+	}
+
+	if res.TxResponse.Code == sdk.CodeTypeOK {
+		return InjTxStatus{Confirmed: true}, nil
+	}
+
+	return InjTxStatus{
+		SequenceMismatch: res.TxResponse.Codespace == sdk.ErrWrongSequence.Codespace() &&
+			res.TxResponse.Code == sdk.ErrWrongSequence.ABCICode(),
+	}, nil
+}
+
+func (c *injectiveClient) LastClaimEventByAddr(ctx context.Context, orchestratorAddr string) (*InjectiveLastClaimEvent, error) {
+	res, err := peggytypes.NewQueryClient(c.chain.QueryClient()).LastClaimEventByAddr(ctx, &peggytypes.QueryLastClaimEventByAddrRequest{
+		Address: orchestratorAddr,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query last claimed event")
+	}
+
+	return &InjectiveLastClaimEvent{
+		EthereumEventNonce:  res.ClaimEvent.EthereumEventNonce,
+		EthereumEventHeight: res.ClaimEvent.EthereumEventHeight,
+	}, nil
+}
+
+func (c *injectiveClient) SendOldDepositClaim(ctx context.Context, event *peggyevents.PeggySendToCosmosEvent, sequence uint64) (string, error) {
+	return c.broadcastClaim(ctx, sequence, &peggytypes.MsgDepositClaim{
+		EventNonce:     event.EventNonce.Uint64(),
+		BlockHeight:    event.Raw.BlockNumber,
+		TokenContract:  event.TokenContract.Hex(),
+		Amount:         sdk.NewIntFromBigInt(event.Amount),
+		EthereumSender: event.Sender.Hex(),
+		CosmosReceiver: event.Destination,
+		Orchestrator:   c.addr,
+	})
+}
+
+func (c *injectiveClient) SendDepositClaim(ctx context.Context, event *peggyevents.PeggySendToInjectiveEvent, sequence uint64) (string, error) {
+	return c.broadcastClaim(ctx, sequence, &peggytypes.MsgSendToInjectiveClaim{
+		EventNonce:     event.EventNonce.Uint64(),
+		BlockHeight:    event.Raw.BlockNumber,
+		TokenContract:  event.TokenContract.Hex(),
+		Amount:         sdk.NewIntFromBigInt(event.Amount),
+		EthereumSender: event.Sender.Hex(),
+		CosmosReceiver: event.Destination,
+		Orchestrator:   c.addr,
+	})
+}
+
+func (c *injectiveClient) SendValsetClaim(ctx context.Context, event *peggyevents.PeggyValsetUpdatedEvent, sequence uint64) (string, error) {
+	return c.broadcastClaim(ctx, sequence, &peggytypes.MsgValsetUpdatedClaim{
+		EventNonce:   event.EventNonce.Uint64(),
+		ValsetNonce:  event.NewValsetNonce.Uint64(),
+		BlockHeight:  event.Raw.BlockNumber,
+		Members:      peggytypes.BridgeValidatorsFromEthereumValset(event),
+		Orchestrator: c.addr,
+	})
+}
+
+func (c *injectiveClient) SendWithdrawalClaim(ctx context.Context, event *peggyevents.PeggyTransactionBatchExecutedEvent, sequence uint64) (string, error) {
+	return c.broadcastClaim(ctx, sequence, &peggytypes.MsgWithdrawClaim{
+		EventNonce:    event.EventNonce.Uint64(),
+		BlockHeight:   event.Raw.BlockNumber,
+		BatchNonce:    event.BatchNonce.Uint64(),
+		TokenContract: event.Token.Hex(),
+		Orchestrator:  c.addr,
+	})
+}
+
+func (c *injectiveClient) SendERC20DeployedClaim(ctx context.Context, event *peggyevents.PeggyERC20DeployedEvent, sequence uint64) (string, error) {
+	return c.broadcastClaim(ctx, sequence, &peggytypes.MsgERC20DeployedClaim{
+		EventNonce:    event.EventNonce.Uint64(),
+		BlockHeight:   event.Raw.BlockNumber,
+		CosmosDenom:   event.CosmosDenom,
+		TokenContract: event.TokenContract.Hex(),
+		Name:          event.Name,
+		Symbol:        event.Symbol,
+		Decimals:      uint64(event.Decimals),
+		Orchestrator:  c.addr,
+	})
+}
+
+// broadcastClaim broadcasts msg through the chain client's SyncBroadcastMsg, returning the
+// resulting tx hash. The sequence argument is not applied to the signed tx: the chain client
+// assigns the account sequence itself.
+func (c *injectiveClient) broadcastClaim(ctx context.Context, sequence uint64, msg sdk.Msg) (string, error) {
+	res, err := c.chain.SyncBroadcastMsg(msg)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to broadcast claim")
+	}
+
+	return res.TxResponse.TxHash, nil
+}
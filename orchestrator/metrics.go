@@ -0,0 +1,162 @@
+package orchestrator
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	// metricReorgsDetectedTotal counts the number of Ethereum reorgs the
+	// oracle loop has detected since process start.
+	metricReorgsDetectedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "reorgs_detected_total",
+		Help:      "Total number of Ethereum reorgs detected by the oracle loop.",
+	})
+
+	// metricReorgDepth records the depth (in blocks) of the most recently
+	// detected Ethereum reorg.
+	metricReorgDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "reorg_depth",
+		Help:      "Depth in blocks of the most recently detected Ethereum reorg.",
+	})
+
+	// metricEventsObservedTotal counts Peggy contract events fetched from Ethereum, by type,
+	// before any nonce filtering is applied.
+	metricEventsObservedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "events_observed_total",
+		Help:      "Total number of Ethereum events observed by the oracle loop, by event type.",
+	}, []string{"event_type"})
+
+	// metricEventsFilteredTotal counts events dropped because their nonce was not newer than
+	// Injective's last claimed event nonce.
+	metricEventsFilteredTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "events_filtered_by_nonce_total",
+		Help:      "Total number of observed events filtered out as already claimed, by event type.",
+	}, []string{"event_type"})
+
+	// metricEventsClaimedTotal counts events whose claim tx was confirmed on Injective.
+	metricEventsClaimedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "events_claimed_total",
+		Help:      "Total number of events successfully claimed on Injective, by event type.",
+	}, []string{"event_type"})
+
+	// metricGetEthEventsDuration times how long each getEthEvents call takes to fetch a block
+	// range's events, regardless of the configured EthEventSource.
+	metricGetEthEventsDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "get_eth_events_duration_seconds",
+		Help:      "Time taken to fetch Ethereum events for a block range.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	// metricSendClaimDuration times how long each claim broadcast to Injective takes, by event
+	// type.
+	metricSendClaimDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "send_claim_duration_seconds",
+		Help:      "Time taken to broadcast a claim to Injective.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"event_type"})
+
+	// metricLastCheckedEthHeight is the last Ethereum height the oracle loop has scanned up to.
+	metricLastCheckedEthHeight = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "last_checked_eth_height",
+		Help:      "Last Ethereum block height scanned by the oracle loop.",
+	})
+
+	// metricLatestEthHeight is the most recently observed Ethereum chain head, confirmation delay
+	// already applied. While the chain is no longer than the confirmation delay it holds the raw
+	// head instead.
+	metricLatestEthHeight = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "latest_eth_height",
+		Help:      "Most recently observed, confirmation-delayed Ethereum chain head (raw head while the chain is no longer than the delay).",
+	})
+
+	// metricLastClaimedEventNonce is the last Ethereum event nonce confirmed as claimed on
+	// Injective, by event type.
+	metricLastClaimedEventNonce = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "last_claimed_event_nonce",
+		Help:      "Last Ethereum event nonce confirmed as claimed on Injective, by event type.",
+	}, []string{"event_type"})
+
+	// metricLoopDuration is the oracle loop's current adaptive sleep interval.
+	metricLoopDuration = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "loop_duration_seconds",
+		Help:      "Oracle loop's current adaptive sleep interval, in seconds.",
+	})
+
+	// metricRetryErrorsTotal counts retried RPC failures, partitioned by the retry stage they
+	// occurred in, so operators can tell an Ethereum RPC flake from an Injective broadcast flake.
+	metricRetryErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "peggo",
+		Subsystem: "oracle",
+		Name:      "retry_errors_total",
+		Help:      "Total number of retried RPC failures, by retry stage.",
+	}, []string{"stage"})
+)
+
+// StartMetricsServer serves the default Prometheus registry on listenAddr until ctx is canceled.
+// It is the caller's responsibility to run this in its own goroutine.
+func StartMetricsServer(ctx context.Context, listenAddr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{Addr: listenAddr, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return errors.Wrap(err, "metrics server failed")
+	}
+
+	return nil
+}
+
+// recordEventsObserved updates metricEventsObservedTotal for every event in events.
+func recordEventsObserved(events ethEvents) {
+	for eventType, count := range events.CountsByType() {
+		if count > 0 {
+			metricEventsObservedTotal.WithLabelValues(eventType).Add(float64(count))
+		}
+	}
+}
+
+// recordEventsFiltered updates metricEventsFilteredTotal with the events present in all but
+// absent from kept, i.e. those dropped because they were already claimed.
+func recordEventsFiltered(all, kept ethEvents) {
+	allCounts, keptCounts := all.CountsByType(), kept.CountsByType()
+
+	for eventType, total := range allCounts {
+		if filtered := total - keptCounts[eventType]; filtered > 0 {
+			metricEventsFilteredTotal.WithLabelValues(eventType).Add(float64(filtered))
+		}
+	}
+}
@@ -2,14 +2,18 @@ package orchestrator
 
 import (
 	"context"
-	"sort"
+	"math/big"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/xlab/suplog"
+	"go.opentelemetry.io/otel/attribute"
 
-	"github.com/InjectiveLabs/peggo/orchestrator/loops"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum"
 	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
 )
 
@@ -21,16 +25,85 @@ const (
 	// the oracle loop can potentially run longer than defaultLoopDur due to a surge of events. This usually happens
 	// when there are more than ~50 events to claim in a single run.
 	defaultBlocksToSearch uint64 = 2000
+
+	// Default number of blocks a claim's source block must be behind the chain head before it is
+	// considered final enough to claim. This is deeper than ethBlockConfirmationDelay and guards
+	// against reorgs that outlive the confirmation delay.
+	defaultFinalityDepth uint64 = 50
+
+	// Number of recent (blockNumber, blockHash) pairs retained for reorg detection.
+	defaultReorgWindowSize uint64 = 64
+
+	// Default number of claim broadcasts allowed in flight at once.
+	defaultMaxInFlightClaims = 5
+
+	// Default interval at which in-flight claim txs are polled for completion.
+	defaultClaimPollInterval = 2 * time.Second
+
+	// Maximum number of times a single claim tx is polled for completion before giving up and
+	// surfacing an error, so a tx silently evicted from the mempool can't wedge the loop forever.
+	maxClaimPollAttempts = 150
+
+	// Upper bound the adaptive loop interval backs off to on a quiet chain.
+	defaultMaxLoopDuration = 5 * time.Minute
+
+	// Number of consecutive iterations with no events before the loop interval starts backing off.
+	idleIterationsBeforeBackoff = 3
+
+	// Bounds blocksToSearch is allowed to grow/shrink between while catching up or idling.
+	minBlocksToSearch uint64 = 500
+	maxBlocksToSearch uint64 = 8000
 )
 
+// OracleLoopConfig bundles the components EthOracleMainLoop needs beyond lastObservedBlock: the
+// Ethereum event source and endpoint pool it reads from, and the checkpoint store and claim
+// pipelining knobs that control how it persists progress and broadcasts claims. Callers build one
+// with NewOracleLoopConfig.
+type OracleLoopConfig struct {
+	EventSource       ethereum.EthEventSource
+	EndpointPool      *ethereum.EndpointPool
+	CheckpointStore   CheckpointStore
+	MaxInFlightClaims int
+	ClaimPollInterval time.Duration
+	MaxLoopDuration   time.Duration
+}
+
 // EthOracleMainLoop is responsible for making sure that Ethereum events are retrieved from the Ethereum blockchain
 // and ferried over to Cosmos where they will be used to issue tokens or process batches.
-func (s *PeggyOrchestrator) EthOracleMainLoop(ctx context.Context, lastObservedBlock uint64) error {
+func (s *PeggyOrchestrator) EthOracleMainLoop(ctx context.Context, lastObservedBlock uint64, cfg OracleLoopConfig) error {
 	loop := ethOracleLoop{
 		PeggyOrchestrator:       s,
 		loopDuration:            defaultLoopDur,
 		lastCheckedEthHeight:    lastObservedBlock,
 		lastResyncWithInjective: time.Now(),
+		finalityDepth:           defaultFinalityDepth,
+		reorgWindowSize:         defaultReorgWindowSize,
+		maxLoopDuration:         cfg.MaxLoopDuration,
+		blocksToSearch:          defaultBlocksToSearch,
+		checkpointStore:         cfg.CheckpointStore,
+		lastSentNonces:          map[string]uint64{},
+		eventSource:             cfg.EventSource,
+		endpointPool:            cfg.EndpointPool,
+		maxInFlightClaims:       cfg.MaxInFlightClaims,
+		claimPollInterval:       cfg.ClaimPollInterval,
+	}
+
+	if loop.checkpointStore != nil {
+		cp, err := loop.checkpointStore.Load()
+		if err != nil {
+			return errors.Wrap(err, "failed to load persisted checkpoint")
+		}
+
+		if cp != nil {
+			loop.lastCheckedEthHeight = cp.LastCheckedEthHeight
+			loop.lastResyncWithInjective = cp.LastResyncWithInjective
+			loop.reorgWindow = cp.ReorgWindow
+			if cp.LastClaimedEventNonces != nil {
+				loop.lastSentNonces = cp.LastClaimedEventNonces
+			}
+
+			loop.Logger().WithField("last_checked_eth_height", cp.LastCheckedEthHeight).Infoln("resumed EthOracle loop from persisted checkpoint")
+		}
 	}
 
 	return loop.Run(ctx)
@@ -41,109 +114,270 @@ type ethOracleLoop struct {
 	loopDuration            time.Duration
 	lastResyncWithInjective time.Time
 	lastCheckedEthHeight    uint64
+
+	// finalityDepth is the number of blocks a claim's source block must be behind the chain head
+	// before a claim is sent for it.
+	finalityDepth uint64
+
+	// reorgWindow is the in-memory, persisted window of recently scanned (blockNumber, blockHash)
+	// pairs used to detect reorgs across restarts.
+	reorgWindow     []blockRecord
+	reorgWindowSize uint64
+
+	// reorgWindowCheckedLen is the length reorgWindow had the last time detectReorg actually
+	// re-fetched headers for it, less any entries trimmed off the window since (a reorg rewind
+	// resets it to 0). Skips the re-fetch on iterations that recorded nothing new, since
+	// nothing in the window could have changed since the last check.
+	reorgWindowCheckedLen int
+
+	// checkpointStore persists lastCheckedEthHeight, lastResyncWithInjective, lastSentNonces and
+	// reorgWindow so the loop can resume after a restart without re-scanning from Injective's last
+	// claimed event.
+	checkpointStore CheckpointStore
+
+	// lastSentNonces is the last Ethereum event nonce claimed per event type, keyed by
+	// eventTypeKey. Guarded by lastSentNoncesMu since it's written from the concurrent
+	// awaitClaimResult goroutines sendClaimsPipelined fans out and read back by persistCheckpoint.
+	lastSentNoncesMu sync.Mutex
+	lastSentNonces   map[string]uint64
+
+	// eventSource is the pluggable source of Ethereum events and heights — either a polling
+	// eth_getLogs source or a push-style websocket subscription.
+	eventSource ethereum.EthEventSource
+
+	// endpointPool rotates between configured Ethereum endpoints once one of them has exhausted
+	// its retry attempts.
+	endpointPool *ethereum.EndpointPool
+
+	// maxInFlightClaims bounds how many claim broadcasts are outstanding at once. Falls back to
+	// defaultMaxInFlightClaims when unset.
+	maxInFlightClaims int
+
+	// claimPollInterval is how often in-flight claim txs are polled for completion. Falls back to
+	// defaultClaimPollInterval when unset.
+	claimPollInterval time.Duration
+
+	// maxLoopDuration caps how far the adaptive loop interval is allowed to back off to on a quiet
+	// chain. Falls back to defaultMaxLoopDuration when unset.
+	maxLoopDuration time.Duration
+
+	// currentInterval is the loop's adaptively adjusted sleep interval; it starts at loopDuration
+	// and backs off towards maxLoopDuration as consecutive iterations find nothing to claim.
+	currentInterval time.Duration
+	idleIterations  int
+
+	// blocksToSearch is the adaptively adjusted Ethereum block range queried per iteration: it
+	// grows while the orchestrator is catching up and shrinks back down once it isn't.
+	blocksToSearch uint64
 }
 
 func (l *ethOracleLoop) Logger() log.Logger {
 	return l.logger.WithField("loop", "EthOracle")
 }
 
+// Run drives the loop on an adaptive interval: it starts at loopDuration and backs off towards
+// maxLoopDuration while consecutive iterations find no events, snapping back to loopDuration as
+// soon as one does. This replaces loops.RunLoop's fixed ticker so quiet chains don't poll at the
+// same cadence as one that's actively producing events.
 func (l *ethOracleLoop) Run(ctx context.Context) error {
+	l.currentInterval = l.loopDuration
+	if l.blocksToSearch == 0 {
+		l.blocksToSearch = defaultBlocksToSearch
+	}
+
 	l.logger.WithField("loop_duration", l.loopDuration.String()).Debugln("starting EthOracle loop...")
 
-	return loops.RunLoop(ctx, l.loopDuration, func() error {
-		latestHeight, err := l.getLatestEthHeight(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(l.currentInterval):
+		}
+
+		foundEvents, err := l.runIteration(ctx)
 		if err != nil {
 			return err
 		}
 
-		// not enough blocks on ethereum yet
-		if latestHeight <= ethBlockConfirmationDelay {
-			return nil
-		}
+		l.currentInterval = l.nextLoopInterval(foundEvents)
+		metricLoopDuration.Set(l.currentInterval.Seconds())
+	}
+}
 
-		// ensure that latest block has minimum confirmations
-		latestHeight = latestHeight - ethBlockConfirmationDelay
-		if latestHeight <= l.lastCheckedEthHeight {
-			return nil
-		}
+// runIteration performs a single scan-and-claim pass and reports whether it found any events, so
+// Run can decide whether to back off the loop interval. The whole pass runs under a single span,
+// with every RPC call it makes as a child span, so loop latency can be attributed to Ethereum
+// reads vs. Injective broadcasts instead of inferred from log timestamps.
+func (l *ethOracleLoop) runIteration(ctx context.Context) (bool, error) {
+	ctx, span := startSpan(ctx, "EthOracleLoop.runIteration")
+	var err error
+	defer func() { endSpan(span, err) }()
+
+	rawLatestHeight, err := l.getLatestEthHeight(ctx)
+	if err != nil {
+		return false, err
+	}
+	metricLatestEthHeight.Set(float64(rawLatestHeight))
 
-		// ensure the block range is within defaultBlocksToSearch
-		if latestHeight > l.lastCheckedEthHeight+defaultBlocksToSearch {
-			latestHeight = l.lastCheckedEthHeight + defaultBlocksToSearch
-		}
+	reorgHeight, err := l.detectReorg(ctx)
+	if err != nil {
+		return false, err
+	}
+	if reorgHeight > 0 {
+		l.rewindForReorg(reorgHeight)
+	}
 
-		events, err := l.getEthEvents(ctx, l.lastCheckedEthHeight, latestHeight)
-		if err != nil {
-			return err
-		}
+	// not enough blocks on ethereum yet
+	if rawLatestHeight <= ethBlockConfirmationDelay {
+		return false, nil
+	}
 
-		if err := l.sendNewEventClaims(ctx, events); err != nil {
-			return err
-		}
+	// ensure that latest block has minimum confirmations
+	latestHeight := rawLatestHeight - ethBlockConfirmationDelay
+	metricLatestEthHeight.Set(float64(latestHeight))
 
-		l.Logger().WithFields(log.Fields{"block_start": l.lastCheckedEthHeight, "block_end": latestHeight}).Debugln("scanned Ethereum blocks")
-		l.lastCheckedEthHeight = latestHeight
-
-		/** Auto re-sync to catch up the nonce. Reasons why event nonce fall behind.
-			1. It takes some time for events to be indexed on Ethereum. So if peggo queried events immediately as block produced, there is a chance the event is missed.
-		   	we need to re-scan this block to ensure events are not missed due to indexing delay.
-			2. if validator was in UnBonding state, the claims broadcasted in last iteration are failed.
-			3. if infura call failed while filtering events, the peggo missed to broadcast claim events occured in last iteration.
-		*/
-		if time.Since(l.lastResyncWithInjective) >= 48*time.Hour {
-			if err := l.autoResync(ctx); err != nil {
-				return err
-			}
+	// a claim's source block must additionally be deeper than finalityDepth
+	if finalized := l.finalizedHeight(rawLatestHeight); finalized < latestHeight {
+		latestHeight = finalized
+	}
+
+	if latestHeight <= l.lastCheckedEthHeight {
+		return false, nil
+	}
+
+	// grow/shrink blocksToSearch based on how far behind the chain head we are
+	l.adaptBlocksToSearch(latestHeight - l.lastCheckedEthHeight)
+
+	// ensure the block range is within blocksToSearch
+	if latestHeight > l.lastCheckedEthHeight+l.blocksToSearch {
+		latestHeight = l.lastCheckedEthHeight + l.blocksToSearch
+	}
+
+	var events ethEvents
+	events, err = l.getEthEvents(ctx, l.lastCheckedEthHeight, latestHeight)
+	if err != nil {
+		return false, err
+	}
+	recordEventsObserved(events)
+
+	if err = l.sendNewEventClaims(ctx, events); err != nil {
+		return false, err
+	}
+
+	var endHeader *ethtypes.Header
+	endHeader, err = l.headerByNumber(ctx, new(big.Int).SetUint64(latestHeight))
+	if err != nil {
+		return false, err
+	}
+
+	l.recordBlock(latestHeight, endHeader.Hash())
+
+	l.Logger().WithFields(log.Fields{"block_start": l.lastCheckedEthHeight, "block_end": latestHeight}).Debugln("scanned Ethereum blocks")
+	l.lastCheckedEthHeight = latestHeight
+	metricLastCheckedEthHeight.Set(float64(l.lastCheckedEthHeight))
+
+	/** Auto re-sync to catch up the nonce. Reasons why event nonce fall behind.
+		1. It takes some time for events to be indexed on Ethereum. So if peggo queried events immediately as block produced, there is a chance the event is missed.
+	   	we need to re-scan this block to ensure events are not missed due to indexing delay.
+		2. if validator was in UnBonding state, the claims broadcasted in last iteration are failed.
+		3. if infura call failed while filtering events, the peggo missed to broadcast claim events occured in last iteration.
+	*/
+	if time.Since(l.lastResyncWithInjective) >= 48*time.Hour {
+		if err = l.autoResync(ctx); err != nil {
+			return false, err
 		}
+	}
 
-		return nil
-	})
+	if err = l.persistCheckpoint(); err != nil {
+		return false, err
+	}
+
+	return events.Num() > 0, nil
 }
 
-func (l *ethOracleLoop) getEthEvents(ctx context.Context, startBlock, endBlock uint64) (ethEvents, error) {
-	events := ethEvents{}
+// nextLoopInterval computes the loop's next sleep interval: it resets to loopDuration as soon as
+// an iteration finds events, and doubles (up to maxLoopDuration) after idleIterationsBeforeBackoff
+// consecutive iterations find none.
+func (l *ethOracleLoop) nextLoopInterval(foundEvents bool) time.Duration {
+	if foundEvents {
+		l.idleIterations = 0
+		return l.loopDuration
+	}
 
-	scanEthEventsFn := func() error {
-		legacyDeposits, err := l.eth.GetSendToCosmosEvents(startBlock, endBlock)
-		if err != nil {
-			return errors.Wrap(err, "failed to get SendToCosmos events")
-		}
+	l.idleIterations++
+	if l.idleIterations < idleIterationsBeforeBackoff {
+		return l.currentInterval
+	}
 
-		deposits, err := l.eth.GetSendToInjectiveEvents(startBlock, endBlock)
-		if err != nil {
-			return errors.Wrap(err, "failed to get SendToInjective events")
-		}
+	maxLoopDuration := l.maxLoopDuration
+	if maxLoopDuration <= 0 {
+		maxLoopDuration = defaultMaxLoopDuration
+	}
 
-		withdrawals, err := l.eth.GetTransactionBatchExecutedEvents(startBlock, endBlock)
-		if err != nil {
-			return errors.Wrap(err, "failed to get TransactionBatchExecuted events")
-		}
+	next := l.currentInterval * 2
+	if next > maxLoopDuration {
+		next = maxLoopDuration
+	}
 
-		erc20Deployments, err := l.eth.GetPeggyERC20DeployedEvents(startBlock, endBlock)
-		if err != nil {
-			return errors.Wrap(err, "failed to get ERC20Deployed events")
+	return next
+}
+
+// adaptBlocksToSearch grows blocksToSearch while the orchestrator is far behind the chain head
+// (catching up) and shrinks it back down once it isn't, within [minBlocksToSearch, maxBlocksToSearch].
+func (l *ethOracleLoop) adaptBlocksToSearch(lag uint64) {
+	switch {
+	case lag > l.blocksToSearch*2 && l.blocksToSearch < maxBlocksToSearch:
+		l.blocksToSearch *= 2
+		if l.blocksToSearch > maxBlocksToSearch {
+			l.blocksToSearch = maxBlocksToSearch
 		}
+	case lag < l.blocksToSearch/4 && l.blocksToSearch > minBlocksToSearch:
+		l.blocksToSearch /= 2
+		if l.blocksToSearch < minBlocksToSearch {
+			l.blocksToSearch = minBlocksToSearch
+		}
+	}
+}
 
-		valsetUpdates, err := l.eth.GetValsetUpdatedEvents(startBlock, endBlock)
-		if err != nil {
-			return errors.Wrap(err, "failed to get ValsetUpdated events")
+// onSourceRetry is shared by every retry.Do call that talks to the configured EthEventSource: it
+// logs the attempt, increments metricRetryErrorsTotal for stage, and, once the endpoint pool has
+// given up on the current endpoint, rotates to the next configured one so the following loop
+// iteration picks it up.
+func (l *ethOracleLoop) onSourceRetry(action, stage string) retry.OnRetryFunc {
+	return func(n uint, err error) {
+		l.Logger().WithError(err).Warningf("%s, will retry (%d)", action, n)
+		metricRetryErrorsTotal.WithLabelValues(stage).Inc()
+
+		if l.endpointPool != nil && n+1 == uint(l.maxAttempts) {
+			l.endpointPool.RotateOnFailure()
 		}
+	}
+}
+
+func (l *ethOracleLoop) getEthEvents(ctx context.Context, startBlock, endBlock uint64) (ethEvents, error) {
+	ctx, span := startSpan(ctx, "EthOracleLoop.getEthEvents",
+		attribute.Int64("start_block", int64(startBlock)),
+		attribute.Int64("end_block", int64(endBlock)),
+	)
+	timer := prometheus.NewTimer(metricGetEthEventsDuration)
+	var events ethEvents
+	var err error
+	defer func() { timer.ObserveDuration(); endSpan(span, err) }()
 
-		events.OldDeposits = legacyDeposits
-		events.Deposits = deposits
-		events.Withdrawals = withdrawals
-		events.ValsetUpdates = valsetUpdates
-		events.ERC20Deployments = erc20Deployments
+	scanEthEventsFn := func() error {
+		fetched, fetchErr := l.eventSource.FetchEvents(ctx, startBlock, endBlock)
+		if fetchErr != nil {
+			return errors.Wrap(fetchErr, "failed to fetch Ethereum events")
+		}
 
+		events = fetched
 		return nil
 	}
 
-	if err := retry.Do(scanEthEventsFn,
+	if err = retry.Do(scanEthEventsFn,
 		retry.Context(ctx),
 		retry.Attempts(l.maxAttempts),
-		retry.OnRetry(func(n uint, err error) {
-			l.Logger().WithError(err).Warningf("error during Ethereum event checking, will retry (%d)", n)
-		}),
+		retry.OnRetry(l.onSourceRetry("error during Ethereum event checking", "fetch_events")),
 	); err != nil {
 		l.Logger().WithError(err).Errorln("got error, loop exits")
 		return ethEvents{}, err
@@ -153,23 +387,25 @@ func (l *ethOracleLoop) getEthEvents(ctx context.Context, startBlock, endBlock u
 }
 
 func (l *ethOracleLoop) getLatestEthHeight(ctx context.Context) (uint64, error) {
+	ctx, span := startSpan(ctx, "EthOracleLoop.getLatestEthHeight")
 	var latestHeight uint64
+	var err error
+	defer func() { endSpan(span, err) }()
+
 	getLatestEthHeightFn := func() error {
-		latestHeader, err := l.eth.HeaderByNumber(ctx, nil)
-		if err != nil {
-			return errors.Wrap(err, "failed to get latest ethereum header")
+		height, heightErr := l.eventSource.LatestHeight(ctx)
+		if heightErr != nil {
+			return errors.Wrap(heightErr, "failed to get latest ethereum height")
 		}
 
-		latestHeight = latestHeader.Number.Uint64()
+		latestHeight = height
 		return nil
 	}
 
-	if err := retry.Do(getLatestEthHeightFn,
+	if err = retry.Do(getLatestEthHeightFn,
 		retry.Context(ctx),
 		retry.Attempts(l.maxAttempts),
-		retry.OnRetry(func(n uint, err error) {
-			l.Logger().WithError(err).Warningf("failed to get latest eth header, will retry (%d)", n)
-		}),
+		retry.OnRetry(l.onSourceRetry("failed to get latest eth height", "latest_height")),
 	); err != nil {
 		l.Logger().WithError(err).Errorln("got error, loop exits")
 		return 0, err
@@ -178,6 +414,34 @@ func (l *ethOracleLoop) getLatestEthHeight(ctx context.Context) (uint64, error)
 	return latestHeight, nil
 }
 
+func (l *ethOracleLoop) headerByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	ctx, span := startSpan(ctx, "EthOracleLoop.headerByNumber")
+	var header *ethtypes.Header
+	var err error
+	defer func() { endSpan(span, err) }()
+
+	getHeaderFn := func() error {
+		h, headerErr := l.eventSource.HeaderByNumber(ctx, number)
+		if headerErr != nil {
+			return errors.Wrap(headerErr, "failed to get ethereum header")
+		}
+
+		header = h
+		return nil
+	}
+
+	if err = retry.Do(getHeaderFn,
+		retry.Context(ctx),
+		retry.Attempts(l.maxAttempts),
+		retry.OnRetry(l.onSourceRetry("failed to get ethereum header", "header_by_number")),
+	); err != nil {
+		l.Logger().WithError(err).Errorln("got error, loop exits")
+		return nil, err
+	}
+
+	return header, nil
+}
+
 func (l *ethOracleLoop) sendNewEventClaims(ctx context.Context, events ethEvents) error {
 	sendEventsFn := func() error {
 		lastClaim, err := l.inj.LastClaimEventByAddr(ctx, l.orchestratorAddr)
@@ -186,25 +450,15 @@ func (l *ethOracleLoop) sendNewEventClaims(ctx context.Context, events ethEvents
 		}
 
 		newEvents := events.Filter(lastClaim.EthereumEventNonce)
+		recordEventsFiltered(events, newEvents)
 		if newEvents.Num() == 0 {
 			l.Logger().WithField("last_claimed_event_nonce", lastClaim.EthereumEventNonce).Infoln("no new events on Ethereum")
 			return nil
 		}
 
 		sortedEvents := newEvents.Sort()
-		for _, event := range sortedEvents {
-			if err := l.sendEthEventClaim(ctx, event); err != nil {
-				return err
-			}
 
-			// Considering blockTime=1s on Injective chain, adding Sleep to make sure new event is sent
-			// only after previous event is executed successfully. Otherwise it will through `non contiguous event nonce` failing CheckTx.
-			time.Sleep(1200 * time.Millisecond)
-		}
-
-		l.Logger().WithField("claims", len(sortedEvents)).Infoln("sent new event claims to Injective")
-
-		return nil
+		return l.sendClaimsPipelined(ctx, sortedEvents)
 	}
 
 	if err := retry.Do(sendEventsFn,
@@ -212,6 +466,7 @@ func (l *ethOracleLoop) sendNewEventClaims(ctx context.Context, events ethEvents
 		retry.Attempts(l.maxAttempts),
 		retry.OnRetry(func(n uint, err error) {
 			l.Logger().WithError(err).Warningf("failed to send events to Injective, will retry (%d)", n)
+			metricRetryErrorsTotal.WithLabelValues("send_claims").Inc()
 		}),
 	); err != nil {
 		l.Logger().WithError(err).Errorln("got error, loop exits")
@@ -221,6 +476,18 @@ func (l *ethOracleLoop) sendNewEventClaims(ctx context.Context, events ethEvents
 	return nil
 }
 
+// getLastClaimBlockHeight returns the Ethereum height of the most recent Peggy event this
+// orchestrator has claimed on Injective, used by autoResync to rewind lastCheckedEthHeight to a
+// known-good point.
+func (l *ethOracleLoop) getLastClaimBlockHeight(ctx context.Context) (uint64, error) {
+	lastClaim, err := l.inj.LastClaimEventByAddr(ctx, l.orchestratorAddr)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get last claimed event from Injective")
+	}
+
+	return lastClaim.EthereumEventHeight, nil
+}
+
 func (l *ethOracleLoop) autoResync(ctx context.Context) error {
 	var latestHeight uint64
 	getLastClaimEventFn := func() (err error) {
@@ -233,6 +500,7 @@ func (l *ethOracleLoop) autoResync(ctx context.Context) error {
 		retry.Attempts(l.maxAttempts),
 		retry.OnRetry(func(n uint, err error) {
 			l.Logger().WithError(err).Warningf("failed to get last claimed event height, will retry (%d)", n)
+			metricRetryErrorsTotal.WithLabelValues("auto_resync").Inc()
 		}),
 	); err != nil {
 		l.Logger().WithError(err).Errorln("got error, loop exits")
@@ -247,124 +515,37 @@ func (l *ethOracleLoop) autoResync(ctx context.Context) error {
 	return nil
 }
 
-func (l *ethOracleLoop) sendEthEventClaim(ctx context.Context, event any) error {
+// sendEthEventClaim signs and broadcasts event with an explicit Cosmos account sequence,
+// returning the resulting tx hash so the caller can track it to completion.
+func (l *ethOracleLoop) sendEthEventClaim(ctx context.Context, event any, sequence uint64) (string, error) {
+	eventType := eventTypeKey(event)
+
+	ctx, span := startSpan(ctx, "EthOracleLoop.sendEthEventClaim",
+		attribute.String("event_type", eventType),
+		attribute.Int64("sequence", int64(sequence)),
+	)
+	timer := prometheus.NewTimer(metricSendClaimDuration.WithLabelValues(eventType))
+	var txHash string
+	var err error
+	defer func() { timer.ObserveDuration(); endSpan(span, err) }()
+
 	switch e := event.(type) {
 	case *peggyevents.PeggySendToCosmosEvent:
-		return l.inj.SendOldDepositClaim(ctx, e)
+		txHash, err = l.inj.SendOldDepositClaim(ctx, e, sequence)
 	case *peggyevents.PeggySendToInjectiveEvent:
-		return l.inj.SendDepositClaim(ctx, e)
+		txHash, err = l.inj.SendDepositClaim(ctx, e, sequence)
 	case *peggyevents.PeggyValsetUpdatedEvent:
-		return l.inj.SendValsetClaim(ctx, e)
+		txHash, err = l.inj.SendValsetClaim(ctx, e, sequence)
 	case *peggyevents.PeggyTransactionBatchExecutedEvent:
-		return l.inj.SendWithdrawalClaim(ctx, e)
+		txHash, err = l.inj.SendWithdrawalClaim(ctx, e, sequence)
 	case *peggyevents.PeggyERC20DeployedEvent:
-		return l.inj.SendERC20DeployedClaim(ctx, e)
+		txHash, err = l.inj.SendERC20DeployedClaim(ctx, e, sequence)
 	default:
 		panic(errors.Errorf("unknown event type %T", e))
 	}
-}
-
-type ethEvents struct {
-	OldDeposits      []*peggyevents.PeggySendToCosmosEvent
-	Deposits         []*peggyevents.PeggySendToInjectiveEvent
-	Withdrawals      []*peggyevents.PeggyTransactionBatchExecutedEvent
-	ValsetUpdates    []*peggyevents.PeggyValsetUpdatedEvent
-	ERC20Deployments []*peggyevents.PeggyERC20DeployedEvent
-}
-
-func (e ethEvents) Num() int {
-	return len(e.OldDeposits) + len(e.Deposits) + len(e.Withdrawals) + len(e.ValsetUpdates) + len(e.ERC20Deployments)
-}
-
-func (e ethEvents) Filter(nonce uint64) ethEvents {
-	var oldDeposits []*peggyevents.PeggySendToCosmosEvent
-	for _, d := range e.OldDeposits {
-		if d.EventNonce.Uint64() > nonce {
-			oldDeposits = append(oldDeposits, d)
-		}
-	}
-
-	var deposits []*peggyevents.PeggySendToInjectiveEvent
-	for _, d := range e.Deposits {
-		if d.EventNonce.Uint64() > nonce {
-			deposits = append(deposits, d)
-		}
-	}
-
-	var withdrawals []*peggyevents.PeggyTransactionBatchExecutedEvent
-	for _, w := range e.Withdrawals {
-		if w.EventNonce.Uint64() > nonce {
-			withdrawals = append(withdrawals, w)
-		}
-	}
-
-	var valsetUpdates []*peggyevents.PeggyValsetUpdatedEvent
-	for _, vs := range e.ValsetUpdates {
-		if vs.EventNonce.Uint64() > nonce {
-			valsetUpdates = append(valsetUpdates, vs)
-		}
-	}
-
-	var erc20Deployments []*peggyevents.PeggyERC20DeployedEvent
-	for _, d := range e.ERC20Deployments {
-		if d.EventNonce.Uint64() > nonce {
-			erc20Deployments = append(erc20Deployments, d)
-		}
-	}
 
-	return ethEvents{
-		OldDeposits:      oldDeposits,
-		Deposits:         deposits,
-		Withdrawals:      withdrawals,
-		ValsetUpdates:    valsetUpdates,
-		ERC20Deployments: erc20Deployments,
-	}
+	return txHash, err
 }
 
-func (e ethEvents) Sort() []any {
-	events := make([]any, 0, e.Num())
-
-	for _, deposit := range e.OldDeposits {
-		events = append(events, deposit)
-	}
-
-	for _, deposit := range e.Deposits {
-		events = append(events, deposit)
-	}
-
-	for _, withdrawal := range e.Withdrawals {
-		events = append(events, withdrawal)
-	}
-
-	for _, deployment := range e.ERC20Deployments {
-		events = append(events, deployment)
-	}
-
-	for _, vs := range e.ValsetUpdates {
-		events = append(events, vs)
-	}
-
-	eventNonce := func(event any) uint64 {
-		switch e := event.(type) {
-		case *peggyevents.PeggySendToCosmosEvent:
-			return e.EventNonce.Uint64()
-		case *peggyevents.PeggySendToInjectiveEvent:
-			return e.EventNonce.Uint64()
-		case *peggyevents.PeggyValsetUpdatedEvent:
-			return e.EventNonce.Uint64()
-		case *peggyevents.PeggyTransactionBatchExecutedEvent:
-			return e.EventNonce.Uint64()
-		case *peggyevents.PeggyERC20DeployedEvent:
-			return e.EventNonce.Uint64()
-		default:
-			panic(errors.Errorf("unknown event type %T", e))
-		}
-	}
-
-	// sort by nonce
-	sort.Slice(events, func(i, j int) bool {
-		return eventNonce(events[i]) < eventNonce(events[j])
-	})
-
-	return events
-}
+// ethEvents is the set of Peggy contract events fetched for a block range.
+type ethEvents = ethereum.Events
@@ -0,0 +1,105 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextLoopIntervalResetsOnEvents(t *testing.T) {
+	loop := &ethOracleLoop{
+		loopDuration:    time.Second,
+		currentInterval: 8 * time.Second,
+		idleIterations:  5,
+	}
+
+	next := loop.nextLoopInterval(true)
+
+	require.Equal(t, time.Second, next)
+	require.Equal(t, 0, loop.idleIterations)
+}
+
+func TestNextLoopIntervalBacksOffAfterIdleThreshold(t *testing.T) {
+	loop := &ethOracleLoop{
+		loopDuration:    time.Second,
+		currentInterval: time.Second,
+		maxLoopDuration: 10 * time.Second,
+	}
+
+	// idleIterationsBeforeBackoff-1 empty iterations: interval must not move yet.
+	for i := 0; i < idleIterationsBeforeBackoff-1; i++ {
+		next := loop.nextLoopInterval(false)
+		require.Equal(t, time.Second, next)
+		loop.currentInterval = next
+	}
+
+	// The iteration that crosses the threshold doubles the interval.
+	next := loop.nextLoopInterval(false)
+	require.Equal(t, 2*time.Second, next)
+}
+
+func TestNextLoopIntervalCapsAtMaxLoopDuration(t *testing.T) {
+	loop := &ethOracleLoop{
+		loopDuration:    time.Second,
+		currentInterval: 8 * time.Second,
+		maxLoopDuration: 10 * time.Second,
+		idleIterations:  idleIterationsBeforeBackoff,
+	}
+
+	next := loop.nextLoopInterval(false)
+
+	require.Equal(t, 10*time.Second, next)
+}
+
+func TestNextLoopIntervalFallsBackToDefaultMaxLoopDuration(t *testing.T) {
+	loop := &ethOracleLoop{
+		loopDuration:    time.Second,
+		currentInterval: defaultMaxLoopDuration,
+		idleIterations:  idleIterationsBeforeBackoff,
+	}
+
+	next := loop.nextLoopInterval(false)
+
+	require.Equal(t, defaultMaxLoopDuration, next)
+}
+
+func TestAdaptBlocksToSearchGrowsWhenCatchingUp(t *testing.T) {
+	loop := &ethOracleLoop{blocksToSearch: 1000}
+
+	loop.adaptBlocksToSearch(3000)
+
+	require.Equal(t, uint64(2000), loop.blocksToSearch)
+}
+
+func TestAdaptBlocksToSearchGrowthCapsAtMax(t *testing.T) {
+	loop := &ethOracleLoop{blocksToSearch: maxBlocksToSearch}
+
+	loop.adaptBlocksToSearch(maxBlocksToSearch * 3)
+
+	require.Equal(t, maxBlocksToSearch, loop.blocksToSearch)
+}
+
+func TestAdaptBlocksToSearchShrinksWhenCaughtUp(t *testing.T) {
+	loop := &ethOracleLoop{blocksToSearch: 2000}
+
+	loop.adaptBlocksToSearch(100)
+
+	require.Equal(t, uint64(1000), loop.blocksToSearch)
+}
+
+func TestAdaptBlocksToSearchShrinkFloorsAtMin(t *testing.T) {
+	loop := &ethOracleLoop{blocksToSearch: minBlocksToSearch}
+
+	loop.adaptBlocksToSearch(0)
+
+	require.Equal(t, minBlocksToSearch, loop.blocksToSearch)
+}
+
+func TestAdaptBlocksToSearchStableLagIsNoop(t *testing.T) {
+	loop := &ethOracleLoop{blocksToSearch: 2000}
+
+	loop.adaptBlocksToSearch(2000)
+
+	require.Equal(t, uint64(2000), loop.blocksToSearch)
+}
@@ -0,0 +1,40 @@
+package orchestrator
+
+import (
+	log "github.com/xlab/suplog"
+)
+
+// defaultMaxAttempts is how many times a retried Ethereum/Injective RPC call is attempted before
+// the orchestrator endpoint pool rotates (if configured) and, once attempts are exhausted, the
+// call surfaces its error to the caller.
+const defaultMaxAttempts uint = 5
+
+// PeggyOrchestrator holds the dependencies shared by every orchestrator loop. The Ethereum side
+// (event source, endpoint pool) is threaded through explicitly via each loop's config (e.g.
+// OracleLoopConfig) since it can change shape per loop; the Injective client and this
+// orchestrator's own address are the same for every loop, so they live here instead.
+type PeggyOrchestrator struct {
+	logger log.Logger
+
+	// maxAttempts bounds how many times a retried RPC call is attempted before giving up.
+	maxAttempts uint
+
+	// inj is this orchestrator's access to the Injective chain: account/claim queries and claim
+	// tx broadcasting.
+	inj InjectiveClient
+
+	// orchestratorAddr is this orchestrator's Injective (Cosmos bech32) address, used to look up
+	// its own last-claimed event.
+	orchestratorAddr string
+}
+
+// NewPeggyOrchestrator builds a PeggyOrchestrator that logs under the given logger, claims events
+// on Injective via inj, and identifies itself on-chain as orchestratorAddr.
+func NewPeggyOrchestrator(logger log.Logger, inj InjectiveClient, orchestratorAddr string) *PeggyOrchestrator {
+	return &PeggyOrchestrator{
+		logger:           logger,
+		maxAttempts:      defaultMaxAttempts,
+		inj:              inj,
+		orchestratorAddr: orchestratorAddr,
+	}
+}
@@ -0,0 +1,148 @@
+package orchestrator
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/xlab/suplog"
+)
+
+// blockRecord is a single entry in the reorg detection window: the Ethereum
+// header hash that was observed for a given height.
+type blockRecord struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// detectReorg re-fetches the headers for every block currently held in the
+// reorg window and compares them against the hashes that were observed when
+// the block was first scanned. It returns the height of the earliest
+// mismatch (0 if none was found) so the caller can rewind to it. Skipped
+// entirely on iterations that recorded nothing new, since a window that
+// hasn't grown can't have picked up a new mismatch since the last check.
+func (l *ethOracleLoop) detectReorg(ctx context.Context) (uint64, error) {
+	if len(l.reorgWindow) == l.reorgWindowCheckedLen {
+		return 0, nil
+	}
+	l.reorgWindowCheckedLen = len(l.reorgWindow)
+
+	actualHash := func(number uint64) (common.Hash, error) {
+		header, err := l.headerByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return common.Hash{}, err
+		}
+
+		return header.Hash(), nil
+	}
+
+	mismatch, err := firstMismatchedRecord(l.reorgWindow, actualHash)
+	if err != nil {
+		return 0, err
+	}
+	if mismatch == nil {
+		return 0, nil
+	}
+
+	l.Logger().WithFields(log.Fields{
+		"height":        mismatch.Number,
+		"expected_hash": mismatch.Hash.Hex(),
+		"actual_hash":   mismatch.actualHash.Hex(),
+	}).Warningln("detected Ethereum reorg")
+
+	return mismatch.Number, nil
+}
+
+// mismatchedRecord pairs a reorg window entry with the hash actually observed for its height.
+type mismatchedRecord struct {
+	blockRecord
+	actualHash common.Hash
+}
+
+// firstMismatchedRecord returns the earliest window entry whose actualHash (as reported by
+// hashAt) no longer matches the hash recorded when it was scanned, or nil if every entry still
+// matches. Pulled out of detectReorg so the comparison can be exercised without a live header
+// source.
+func firstMismatchedRecord(window []blockRecord, hashAt func(number uint64) (common.Hash, error)) (*mismatchedRecord, error) {
+	for _, rec := range window {
+		hash, err := hashAt(rec.Number)
+		if err != nil {
+			return nil, err
+		}
+
+		if hash != rec.Hash {
+			return &mismatchedRecord{blockRecord: rec, actualHash: hash}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// rewindForReorg drops the reorg window's block records at and above height
+// and rewinds lastCheckedEthHeight so those blocks will be re-scanned. Events
+// buffered by the event source are not dropped here.
+func (l *ethOracleLoop) rewindForReorg(height uint64) {
+	depth := reorgDepth(l.lastCheckedEthHeight, height)
+	l.reorgWindow = trimReorgWindow(l.reorgWindow, height)
+	l.reorgWindowCheckedLen = 0
+	l.lastCheckedEthHeight = height - 1
+
+	metricReorgsDetectedTotal.Inc()
+	metricReorgDepth.Set(float64(depth))
+
+	l.Logger().WithFields(log.Fields{
+		"rewound_to":  l.lastCheckedEthHeight,
+		"reorg_depth": depth,
+	}).Infoln("rewound lastCheckedEthHeight after reorg")
+}
+
+// reorgDepth reports how many blocks were rolled back by a rewind from lastCheckedEthHeight to
+// height, or 0 if height is not actually behind lastCheckedEthHeight.
+func reorgDepth(lastCheckedEthHeight, height uint64) uint64 {
+	if lastCheckedEthHeight > height {
+		return lastCheckedEthHeight - height + 1
+	}
+
+	return 0
+}
+
+// trimReorgWindow drops every record at or above height, since those blocks are about to be
+// re-scanned and may no longer be final.
+func trimReorgWindow(window []blockRecord, height uint64) []blockRecord {
+	kept := window[:0]
+	for _, rec := range window {
+		if rec.Number < height {
+			kept = append(kept, rec)
+		}
+	}
+
+	return kept
+}
+
+// recordBlock appends a scanned block's height/hash to the in-memory reorg window and trims it to
+// reorgWindowSize. The window is persisted as part of the loop's overall checkpoint.
+func (l *ethOracleLoop) recordBlock(number uint64, hash common.Hash) {
+	l.reorgWindow = append(l.reorgWindow, blockRecord{Number: number, Hash: hash})
+
+	if uint64(len(l.reorgWindow)) > l.reorgWindowSize {
+		trimmed := uint64(len(l.reorgWindow)) - l.reorgWindowSize
+		l.reorgWindow = l.reorgWindow[trimmed:]
+
+		// the trimmed entries were the oldest ones, so the checked length shrinks with them
+		l.reorgWindowCheckedLen -= int(trimmed)
+		if l.reorgWindowCheckedLen < 0 {
+			l.reorgWindowCheckedLen = 0
+		}
+	}
+}
+
+// finalizedHeight returns the highest Ethereum block height that satisfies
+// the configured finality depth, i.e. claims sourced from blocks above this
+// height must be withheld until they have matured further.
+func (l *ethOracleLoop) finalizedHeight(latestHeight uint64) uint64 {
+	if latestHeight <= l.finalityDepth {
+		return 0
+	}
+
+	return latestHeight - l.finalityDepth
+}
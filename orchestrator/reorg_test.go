@@ -0,0 +1,110 @@
+package orchestrator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum"
+)
+
+func TestTrimReorgWindow(t *testing.T) {
+	window := []blockRecord{
+		{Number: 10}, {Number: 11}, {Number: 12}, {Number: 13},
+	}
+
+	kept := trimReorgWindow(window, 12)
+
+	require.Equal(t, []blockRecord{{Number: 10}, {Number: 11}}, kept)
+}
+
+func TestReorgDepth(t *testing.T) {
+	require.Equal(t, uint64(3), reorgDepth(13, 11))
+	require.Equal(t, uint64(0), reorgDepth(10, 10))
+	require.Equal(t, uint64(0), reorgDepth(5, 10))
+}
+
+func TestFirstMismatchedRecord(t *testing.T) {
+	hashA := common.HexToHash("0x1")
+	hashB := common.HexToHash("0x2")
+
+	window := []blockRecord{
+		{Number: 10, Hash: hashA},
+		{Number: 11, Hash: hashA},
+		{Number: 12, Hash: hashA},
+	}
+
+	t.Run("no mismatch", func(t *testing.T) {
+		mismatch, err := firstMismatchedRecord(window, func(uint64) (common.Hash, error) { return hashA, nil })
+
+		require.NoError(t, err)
+		require.Nil(t, mismatch)
+	})
+
+	t.Run("mismatch at earliest height", func(t *testing.T) {
+		mismatch, err := firstMismatchedRecord(window, func(number uint64) (common.Hash, error) {
+			if number >= 11 {
+				return hashB, nil
+			}
+			return hashA, nil
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, mismatch)
+		require.Equal(t, uint64(11), mismatch.Number)
+		require.Equal(t, hashB, mismatch.actualHash)
+	})
+}
+
+// headerByNumberSource is a minimal ethereum.EthEventSource that only serves HeaderByNumber,
+// recording how many times it was called. detectReorg never touches its other methods.
+type headerByNumberSource struct {
+	ethereum.EthEventSource
+	calls int
+}
+
+func (s *headerByNumberSource) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	s.calls++
+	return &ethtypes.Header{Number: number}, nil
+}
+
+func TestDetectReorgSkipsHeaderFetchesWhenWindowUnchanged(t *testing.T) {
+	source := &headerByNumberSource{}
+	recordedHash := (&ethtypes.Header{Number: big.NewInt(10)}).Hash()
+	loop := &ethOracleLoop{
+		PeggyOrchestrator: NewPeggyOrchestrator(log.WithField("test", "reorg"), nil, ""),
+		eventSource:       source,
+		reorgWindow:       []blockRecord{{Number: 10, Hash: recordedHash}},
+		reorgWindowSize:   defaultReorgWindowSize,
+	}
+
+	// first call has nothing checked yet, so it must re-fetch the header for the one window entry
+	height, err := loop.detectReorg(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), height, "the fetched header's hash matches what's recorded, so no reorg")
+	require.Equal(t, 1, source.calls)
+	require.Equal(t, 1, loop.reorgWindowCheckedLen)
+
+	// the window hasn't grown since the last check, so a second call must skip the re-fetch
+	_, err = loop.detectReorg(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, source.calls, "detectReorg must not re-fetch headers when reorgWindow hasn't changed")
+
+	// once a new block is recorded, the next check must resume fetching
+	loop.recordBlock(11, common.HexToHash("0x2"))
+	_, err = loop.detectReorg(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, source.calls, "a grown window must be re-checked in full")
+}
+
+func TestFinalizedHeight(t *testing.T) {
+	loop := &ethOracleLoop{finalityDepth: 50}
+
+	require.Equal(t, uint64(0), loop.finalizedHeight(40))
+	require.Equal(t, uint64(50), loop.finalizedHeight(100))
+}
@@ -0,0 +1,30 @@
+package orchestrator
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer is the package-wide OpenTelemetry tracer every span in the orchestrator is started from.
+var tracer = otel.Tracer("github.com/InjectiveLabs/peggo/orchestrator")
+
+// startSpan starts a child span under ctx and returns it alongside the derived context. It does
+// not end the span; pair it with endSpan, which sets an error status if err is non-nil.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records err on span (if any) and ends it. It is meant to be deferred immediately after
+// startSpan, e.g. `ctx, span := startSpan(ctx, "name"); defer func() { endSpan(span, err) }()`.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}